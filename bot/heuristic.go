@@ -0,0 +1,394 @@
+package bot
+
+import (
+	"slices"
+
+	"github.com/victoroliveirab/settlers/core"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Heuristic plays using rules of thumb over the current state, without looking ahead.
+// It never creates trade offers, it only responds to them.
+type Heuristic struct{}
+
+func NewHeuristic() *Heuristic {
+	return &Heuristic{}
+}
+
+// NextAction returns the command playerID should issue now, or false if the player has nothing to do.
+// Outside of its own turn a player can only have cards to discard, gold to pick or trade offers to respond to.
+func (bot *Heuristic) NextAction(game *core.GameState, playerID string) (core.Command, bool) {
+	actions := game.LegalActions(playerID)
+	if len(actions) == 0 {
+		return core.Command{}, false
+	}
+	v := newView(game, playerID)
+
+	for _, action := range actions {
+		if action.Type == core.CommandDiscardPlayerCards {
+			command := action.Command
+			command.Resources = bot.discard(v, action.DiscardAmount)
+			return command, true
+		}
+		if action.Type == core.CommandPickGoldResources {
+			command := action.Command
+			command.Resources = bot.pickGold(v, action.GoldPickAmount)
+			return command, true
+		}
+	}
+
+	if command, ok := bot.respondToTrade(v, actions); ok {
+		return command, true
+	}
+
+	if !game.IsPlayerTurn(playerID) {
+		return core.Command{}, false
+	}
+
+	switch game.RoundType() {
+	case round.SetupSettlement1, round.SetupSettlement2:
+		return bestAction(actions, core.CommandBuildSettlement, func(action core.LegalAction) int {
+			return v.vertexValue(action.VertexID)
+		})
+	case round.SetupRoad1, round.SetupRoad2:
+		return bestAction(actions, core.CommandBuildRoad, func(action core.LegalAction) int {
+			return v.edgeValue(action.EdgeID)
+		})
+	case round.BuildRoad1Development, round.BuildRoad2Development:
+		return bestAction(actions, core.CommandPickRoadBuildingSpot, func(action core.LegalAction) int {
+			return v.edgeValue(action.EdgeID)
+		})
+	case round.FirstRound, round.BetweenTurns:
+		if command, ok := bot.knight(v, actions); ok {
+			return command, true
+		}
+		return bestAction(actions, core.CommandRollDice, nil)
+	case round.Regular:
+		return bot.regularAction(v, actions)
+	case round.SpecialBuild:
+		if command, ok := bot.buildAction(v, actions); ok {
+			return command, true
+		}
+		return hasAction(actions, core.CommandEndRound, "")
+	case round.MoveRobberDue7, round.MoveRobberDueKnight:
+		return bestAction(actions, core.CommandMoveRobber, func(action core.LegalAction) int {
+			return bot.robberTileValue(v, action.TileID)
+		})
+	case round.PickRobbed:
+		points := game.PublicPoints()
+		return bestAction(actions, core.CommandRobPlayer, func(action core.LegalAction) int {
+			cards := game.NumberOfCardsInHandByPlayer(action.TargetPlayerID)
+			if cards == 0 {
+				// Nothing to take, only picked when nobody else can be robbed
+				return 0
+			}
+			return points[action.TargetPlayerID]*100 + cards
+		})
+	case round.MonopolyPickResource:
+		resource := v.neededResource(v.hand)
+		return bestAction(actions, core.CommandPickMonopolyResource, func(action core.LegalAction) int {
+			if action.Resource == resource {
+				return 1
+			}
+			return 0
+		})
+	case round.YearOfPlentyPickResources:
+		resource1 := v.neededResource(v.hand)
+		resource2 := v.neededResource(v.hand.Add(coreT.ResourcesOf(resource1, 1)))
+		// The bank may lack the wanted resources, so the legal pair closest to them is picked
+		return bestAction(actions, core.CommandPickYearOfPlentyResources, func(action core.LegalAction) int {
+			wanted := coreT.ResourcesOf(resource1, 1).Add(coreT.ResourcesOf(resource2, 1))
+			value := 0
+			for _, resource := range []coreT.Resource{action.Resource, action.SecondResource} {
+				if wanted.Get(resource) > 0 {
+					wanted = wanted.Subtract(coreT.ResourcesOf(resource, 1))
+					value++
+				}
+			}
+			return value
+		})
+	}
+	return core.Command{}, false
+}
+
+// bestAction returns the action of the given type with highest value, keeping the first one on ties
+func bestAction(actions []core.LegalAction, commandType core.CommandType, value func(core.LegalAction) int) (core.Command, bool) {
+	var best *core.LegalAction
+	bestValue := 0
+	for i := range actions {
+		if actions[i].Type != commandType {
+			continue
+		}
+		actionValue := 0
+		if value != nil {
+			actionValue = value(actions[i])
+		}
+		if best == nil || actionValue > bestValue {
+			best = &actions[i]
+			bestValue = actionValue
+		}
+	}
+	if best == nil {
+		return core.Command{}, false
+	}
+	return best.Command, true
+}
+
+func hasAction(actions []core.LegalAction, commandType core.CommandType, devCardType string) (core.Command, bool) {
+	for _, action := range actions {
+		if action.Type == commandType && action.DevelopmentCard == devCardType {
+			return action.Command, true
+		}
+	}
+	return core.Command{}, false
+}
+
+func (bot *Heuristic) regularAction(v *view, actions []core.LegalAction) (core.Command, bool) {
+	if command, ok := bot.knight(v, actions); ok {
+		return command, true
+	}
+	for _, devCardType := range []string{"Year of Plenty", "Road Building", "Monopoly"} {
+		if command, ok := hasAction(actions, core.CommandUseDevelopmentCard, devCardType); ok {
+			if devCardType != "Road Building" || v.bestEdgeValue(actions, core.CommandBuildRoad) > 0 {
+				return command, true
+			}
+		}
+	}
+
+	if command, ok := bot.buildAction(v, actions); ok {
+		return command, true
+	}
+
+	if command, ok := bot.bankTrade(v, v.goal(), actions); ok {
+		return command, true
+	}
+
+	return hasAction(actions, core.CommandEndRound, "")
+}
+
+// buildAction picks what to build or buy with the hand as is, without trading
+func (bot *Heuristic) buildAction(v *view, actions []core.LegalAction) (core.Command, bool) {
+	if command, ok := bestAction(actions, core.CommandBuildCity, func(action core.LegalAction) int {
+		return v.productionValue(action.VertexID)
+	}); ok {
+		return command, true
+	}
+	if command, ok := bestAction(actions, core.CommandBuildSettlement, func(action core.LegalAction) int {
+		return v.vertexValue(action.VertexID)
+	}); ok {
+		return command, true
+	}
+	if !v.hasSettlementSpot() && len(v.game.SettlementsByPlayer(v.playerID)) < v.settings.MaxSettlements {
+		if v.bestEdgeValue(actions, core.CommandBuildRoad) > 0 {
+			return bestAction(actions, core.CommandBuildRoad, func(action core.LegalAction) int {
+				return v.edgeValue(action.EdgeID)
+			})
+		}
+		// With nowhere to go by land, sail towards another island
+		if v.bestEdgeValue(actions, core.CommandBuildShip) > 0 {
+			return bestAction(actions, core.CommandBuildShip, func(action core.LegalAction) int {
+				return v.edgeValue(action.EdgeID)
+			})
+		}
+	}
+
+	if command, ok := hasAction(actions, core.CommandBuyDevelopmentCard, ""); ok {
+		goal := v.goal()
+		hand := v.hand.Subtract(coreT.DevelopmentCardCost)
+		if missing(goal, hand) <= missing(goal, v.hand) {
+			return command, true
+		}
+	}
+	return core.Command{}, false
+}
+
+func (v *view) hasSettlementSpot() bool {
+	for _, edgeID := range append(slices.Clone(v.game.RoadsByPlayer(v.playerID)), v.game.ShipsByPlayer(v.playerID)...) {
+		for _, vertexID := range v.game.VerticesByEdge(edgeID) {
+			if v.settleable(vertexID) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *view) bestEdgeValue(actions []core.LegalAction, commandType core.CommandType) int {
+	best := 0
+	for _, action := range actions {
+		if action.Type == commandType {
+			best = max(best, v.edgeValue(action.EdgeID))
+		}
+	}
+	return best
+}
+
+// bankTrade gives away cards the goal doesn't need for cards it's missing, preferring the cheapest rate
+func (bot *Heuristic) bankTrade(v *view, goal coreT.Resources, actions []core.LegalAction) (core.Command, bool) {
+	var best *core.Command
+	bestCost := 0
+	for i := range actions {
+		command := actions[i].Command
+		if command.Type != core.CommandMakeBankTrade && command.Type != core.CommandMakeGeneralPortTrade && command.Type != core.CommandMakeResourcePortTrade {
+			continue
+		}
+		cost := command.Given.Total()
+		surplus := true
+		needed := true
+		for _, resource := range core.ResourcesOrder {
+			if quantity := command.Given.Get(resource); quantity > 0 && v.hand.Get(resource)-quantity < goal.Get(resource) {
+				surplus = false
+			}
+			if command.Requested.Get(resource) > 0 && v.hand.Get(resource) >= goal.Get(resource) {
+				needed = false
+			}
+		}
+		if !surplus || !needed {
+			continue
+		}
+		if best == nil || cost < bestCost {
+			best = &command
+			bestCost = cost
+		}
+	}
+	if best == nil {
+		return core.Command{}, false
+	}
+	return *best, true
+}
+
+// knight plays a knight when the robber blocks the player or when it wins the most knights award
+func (bot *Heuristic) knight(v *view, actions []core.LegalAction) (core.Command, bool) {
+	command, ok := hasAction(actions, core.CommandUseDevelopmentCard, "Knight")
+	if !ok {
+		return core.Command{}, false
+	}
+
+	for _, tileID := range v.game.BlockedTiles() {
+		for _, vertexID := range v.tiles[tileID].Vertices {
+			if building, exists := v.buildings[vertexID]; exists && building.Owner == v.playerID && pips(v.tiles[tileID].Token) > 0 {
+				return command, true
+			}
+		}
+	}
+
+	knights := v.game.KnightUses()
+	mostByOthers := 0
+	for playerID, quantity := range knights {
+		if playerID != v.playerID {
+			mostByOthers = max(mostByOthers, quantity)
+		}
+	}
+	own := knights[v.playerID]
+	if own <= mostByOthers && own+1 > mostByOthers && own+1 >= v.settings.MostKnightsMinimum {
+		return command, true
+	}
+	return core.Command{}, false
+}
+
+// robberTileValue hurts the players with most points the most and never blocks the player itself
+func (bot *Heuristic) robberTileValue(v *view, tileID int) int {
+	tile := v.tiles[tileID]
+	tilePips := pips(tile.Token)
+	points := v.game.PublicPoints()
+	leader := v.leader()
+	value := 0
+	for _, vertexID := range tile.Vertices {
+		building, exists := v.buildings[vertexID]
+		if !exists {
+			continue
+		}
+		if building.Owner == v.playerID {
+			value -= 100
+			continue
+		}
+		multiplier := 1
+		if v.cities[vertexID] {
+			multiplier = 2
+		}
+		weight := 1 + points[building.Owner]
+		if building.Owner == leader {
+			weight += 2
+		}
+		value += tilePips * multiplier * weight
+	}
+	return value
+}
+
+// discard drops the cards that are furthest from being needed by the current goal
+func (bot *Heuristic) discard(v *view, amount int) coreT.Resources {
+	goal := v.goal()
+	hand := v.hand
+	discarded := coreT.Resources{}
+	for i := 0; i < amount; i++ {
+		best := coreT.Resource("")
+		for _, resource := range core.ResourcesOrder {
+			if hand.Get(resource) == 0 {
+				continue
+			}
+			if best == "" || hand.Get(resource)-goal.Get(resource) > hand.Get(best)-goal.Get(best) {
+				best = resource
+			}
+		}
+		if best == "" {
+			break
+		}
+		hand = hand.Subtract(coreT.ResourcesOf(best, 1))
+		discarded = discarded.Add(coreT.ResourcesOf(best, 1))
+	}
+	return discarded
+}
+
+// pickGold takes, one card at a time, the resource the hand lacks the most for the current goal.
+// When the bank ran out of it, the resource the bank has the most of is taken instead
+func (bot *Heuristic) pickGold(v *view, amount int) coreT.Resources {
+	bank := v.game.BankResources()
+	hand := v.hand
+	picked := coreT.Resources{}
+	for i := 0; i < amount; i++ {
+		resource := v.neededResource(hand)
+		if bank.Get(resource) == 0 {
+			for _, candidate := range core.ResourcesOrder {
+				if bank.Get(candidate) > bank.Get(resource) {
+					resource = candidate
+				}
+			}
+		}
+		if bank.Get(resource) == 0 {
+			break
+		}
+		card := coreT.ResourcesOf(resource, 1)
+		bank = bank.Subtract(card)
+		hand = hand.Add(card)
+		picked = picked.Add(card)
+	}
+	return picked
+}
+
+// respondToTrade answers offers still waiting for the player's response.
+// Offers are accepted when they bring the player closer to its goal and the offering player isn't about to win.
+func (bot *Heuristic) respondToTrade(v *view, actions []core.LegalAction) (core.Command, bool) {
+	pending := pendingTrade(v.game, v.playerID)
+	if pending == nil {
+		return core.Command{}, false
+	}
+
+	goal := v.goal()
+	hand := v.hand.Subtract(pending.Request).Add(pending.Offer)
+	points := v.game.PublicPoints()
+	accept := missing(goal, hand) < missing(goal, v.hand) && points[pending.Requester] < v.settings.TargetPoint-2
+
+	for _, action := range actions {
+		if action.TradeID != pending.ID {
+			continue
+		}
+		if accept && action.Type == core.CommandAcceptTradeOffer {
+			return action.Command, true
+		}
+		if !accept && action.Type == core.CommandRejectTradeOffer {
+			return action.Command, true
+		}
+	}
+	return core.Command{}, false
+}
@@ -0,0 +1,52 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func TestHeuristicPlaysFullGame(t *testing.T) {
+	for _, seed := range []int64{1, 42, 1337} {
+		heuristic := NewHeuristic()
+		playGame(t, createGame(t, seed), map[string]Bot{"1": heuristic, "2": heuristic, "3": heuristic, "4": heuristic})
+	}
+}
+
+func TestHeuristicSetupSettlement(t *testing.T) {
+	// On seed 7, vertex#7 is the only available spot with 15 pips
+	game := createGame(t, 7)
+	command, ok := NewHeuristic().NextAction(game, "1")
+	if !ok || command.Type != core.CommandBuildSettlement {
+		t.Fatalf("expected player#1 to build a settlement, but actually got %+v", command)
+	}
+
+	pipSums := make(map[int]int)
+	for _, tile := range game.GetBoard() {
+		for _, vertexID := range tile.Vertices {
+			pipSums[vertexID] += pips(tile.Token)
+		}
+	}
+	best := 0
+	vertices, _ := game.AvailableVertices("1")
+	for _, vertexID := range vertices {
+		if pipSums[vertexID] > pipSums[best] {
+			best = vertexID
+		}
+	}
+	if command.VertexID != best {
+		t.Errorf("expected vertex#%d with %d pips to be picked, but actually picked vertex#%d with %d pips", best, pipSums[best], command.VertexID, pipSums[command.VertexID])
+	}
+}
+
+func TestHeuristicDiscard(t *testing.T) {
+	game := createGame(t, 42)
+	v := newView(game, "1")
+	v.hand = coreT.Resources{Lumber: 5, Brick: 1, Sheep: 1, Grain: 1}
+
+	discarded := NewHeuristic().discard(v, 4)
+	if discarded.Lumber != 4 {
+		t.Errorf("expected to discard 4 lumber kept over the goal, but actually discarded %v", discarded)
+	}
+}
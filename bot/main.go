@@ -0,0 +1,311 @@
+package bot
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/victoroliveirab/settlers/core"
+	"github.com/victoroliveirab/settlers/core/packages/board"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	"github.com/victoroliveirab/settlers/core/packages/trade"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+type Difficulty string
+
+const (
+	Easy   Difficulty = "easy"
+	Normal Difficulty = "normal"
+	Hard   Difficulty = "hard"
+)
+
+var Difficulties = []Difficulty{Easy, Normal, Hard}
+
+// Bot decides the commands of a seat. See Heuristic.NextAction for the contract
+type Bot interface {
+	NextAction(game *core.GameState, playerID string) (core.Command, bool)
+}
+
+// New creates a bot of the given difficulty.
+// budget is how long the hard bot may think per decision and seed feeds its randomness
+func New(difficulty Difficulty, budget time.Duration, seed int64) (Bot, error) {
+	switch difficulty {
+	case Easy:
+		return NewRandom(seed), nil
+	case Normal:
+		return NewHeuristic(), nil
+	case Hard:
+		return NewRollout(budget, seed), nil
+	}
+	err := fmt.Errorf("Unknown bot difficulty: %s", difficulty)
+	return nil, err
+}
+
+// Play lets bots issue commands for every seat until the game is over, at most maxCommands times.
+// It returns the number of commands applied
+func Play(game *core.GameState, bots map[string]Bot, maxCommands int) (int, error) {
+	commands := 0
+	for commands < maxCommands && game.RoundType() != round.GameOver {
+		acted := false
+		for _, player := range game.Players() {
+			command, ok := bots[player.ID].NextAction(game, player.ID)
+			if !ok {
+				continue
+			}
+			err := game.ApplyCommand(command)
+			if err != nil {
+				err := fmt.Errorf("Command %s of player %s rejected at round %d: %s", command.Type, player.ID, game.Round(), err.Error())
+				return commands, err
+			}
+			commands++
+			acted = true
+			break
+		}
+		if !acted {
+			err := fmt.Errorf("No player can act during %s at round %d", round.RoundTypeTranslation[game.RoundType()], game.Round())
+			return commands, err
+		}
+	}
+
+	if game.RoundType() != round.GameOver {
+		err := fmt.Errorf("Game not over after %d commands", commands)
+		return commands, err
+	}
+	return commands, nil
+}
+
+// pendingTrade returns the first active trade offer still waiting for playerID's response
+func pendingTrade(game *core.GameState, playerID string) *trade.Trade {
+	for _, t := range game.ActiveTradeOffers() {
+		response := t.Responses[playerID]
+		if t.Requester == playerID || response == nil || response.Blocked || response.Status != trade.NoResponse {
+			continue
+		}
+		return &t
+	}
+	return nil
+}
+
+// view caches what the bot reads from the game while taking a single decision
+type view struct {
+	game       *core.GameState
+	playerID   string
+	settings   coreT.Settings
+	board      []coreT.MapBlock
+	tiles      map[int]coreT.MapBlock
+	buildings  map[int]board.Building
+	cities     map[int]bool
+	hand       coreT.Resources
+	production coreT.Resources
+}
+
+func newView(game *core.GameState, playerID string) *view {
+	v := &view{
+		game:       game,
+		playerID:   playerID,
+		settings:   game.GetSettings(),
+		board:      game.GetBoard(),
+		tiles:      make(map[int]coreT.MapBlock),
+		buildings:  make(map[int]board.Building),
+		cities:     make(map[int]bool),
+		hand:       game.ResourceHandByPlayer(playerID),
+		production: coreT.Resources{},
+	}
+	for _, tile := range v.board {
+		v.tiles[tile.ID] = tile
+	}
+	for vertexID, building := range game.GetAllSettlements() {
+		v.buildings[vertexID] = building
+	}
+	for vertexID, building := range game.GetAllCities() {
+		v.buildings[vertexID] = building
+		v.cities[vertexID] = true
+	}
+	for vertexID, building := range v.buildings {
+		if building.Owner != playerID {
+			continue
+		}
+		multiplier := 1
+		if v.cities[vertexID] {
+			multiplier = 2
+		}
+		for _, tile := range v.tilesAround(vertexID) {
+			v.production = v.production.Add(coreT.ResourcesOf(coreT.Resource(tile.Resource), pips(tile.Token)*multiplier))
+		}
+	}
+	return v
+}
+
+// tilesAround returns the tiles touching vertexID. TilesByVertex lists them by their position on the board, not by ID
+func (v *view) tilesAround(vertexID int) []coreT.MapBlock {
+	tiles := make([]coreT.MapBlock, 0, 3)
+	for _, index := range v.game.TilesByVertex(vertexID) {
+		tiles = append(tiles, v.board[index])
+	}
+	return tiles
+}
+
+// pips is the number of dice combinations out of 36 that roll token
+func pips(token int) int {
+	if token < 2 || token > 12 || token == 7 {
+		return 0
+	}
+	if token < 7 {
+		return token - 1
+	}
+	return 13 - token
+}
+
+func missing(cost, hand coreT.Resources) int {
+	count := 0
+	for _, resource := range core.ResourcesOrder {
+		count += max(0, cost.Get(resource)-hand.Get(resource))
+	}
+	return count
+}
+
+func (v *view) neighbours(vertexID int) []int {
+	neighbours := make([]int, 0, 3)
+	for _, edgeID := range v.game.EdgesByVertex(vertexID) {
+		for _, otherID := range v.game.VerticesByEdge(edgeID) {
+			if otherID != vertexID {
+				neighbours = append(neighbours, otherID)
+			}
+		}
+	}
+	return neighbours
+}
+
+// settleable tells whether the distance rule allows a settlement at vertexID
+func (v *view) settleable(vertexID int) bool {
+	if _, exists := v.buildings[vertexID]; exists {
+		return false
+	}
+	for _, neighbourID := range v.neighbours(vertexID) {
+		if _, exists := v.buildings[neighbourID]; exists {
+			return false
+		}
+	}
+	return true
+}
+
+// vertexValue favours high pips, resources the player doesn't produce yet and useful ports
+func (v *view) vertexValue(vertexID int) int {
+	value := 0
+	resources := make(map[string]bool)
+	for _, tile := range v.tilesAround(vertexID) {
+		tilePips := pips(tile.Token)
+		if tilePips == 0 {
+			continue
+		}
+		value += tilePips
+		if v.production.Get(coreT.Resource(tile.Resource)) == 0 && !resources[tile.Resource] {
+			value += 3
+		}
+		resources[tile.Resource] = true
+	}
+	if port, exists := v.game.PortsByVertex()[vertexID]; exists {
+		if port == "General" {
+			value += 1
+		} else if v.production.Get(coreT.Resource(port)) > 0 || resources[port] {
+			value += 2
+		}
+	}
+	return value
+}
+
+// productionValue is the number of pips a settlement at vertexID collects
+func (v *view) productionValue(vertexID int) int {
+	value := 0
+	for _, tile := range v.tilesAround(vertexID) {
+		value += pips(tile.Token)
+	}
+	return value
+}
+
+func (v *view) connected(vertexID int) bool {
+	if building, exists := v.buildings[vertexID]; exists && building.Owner == v.playerID {
+		return true
+	}
+	for _, edgeID := range append(slices.Clone(v.game.RoadsByPlayer(v.playerID)), v.game.ShipsByPlayer(v.playerID)...) {
+		vertices := v.game.VerticesByEdge(edgeID)
+		if vertices[0] == vertexID || vertices[1] == vertexID {
+			return true
+		}
+	}
+	return false
+}
+
+// edgeValue is the value of the best settlement spot a road at edgeID leads to, or 0 if it leads nowhere
+func (v *view) edgeValue(edgeID int) int {
+	best := 0
+	for _, vertexID := range v.game.VerticesByEdge(edgeID) {
+		if v.connected(vertexID) {
+			continue
+		}
+		if building, exists := v.buildings[vertexID]; exists && building.Owner != v.playerID {
+			continue
+		}
+		if v.settleable(vertexID) {
+			best = max(best, v.vertexValue(vertexID))
+		}
+		for _, neighbourID := range v.neighbours(vertexID) {
+			if v.settleable(neighbourID) {
+				best = max(best, v.vertexValue(neighbourID)-1)
+			}
+		}
+	}
+	return best
+}
+
+// goal is the cost of what the player is closer to afford among city, settlement and development card
+func (v *view) goal() coreT.Resources {
+	candidates := make([]coreT.Resources, 0, 3)
+	settlements := len(v.game.SettlementsByPlayer(v.playerID))
+	if settlements > 0 && len(v.game.CitiesByPlayer(v.playerID)) < v.settings.MaxCities {
+		candidates = append(candidates, coreT.CityCost)
+	}
+	if settlements < v.settings.MaxSettlements {
+		candidates = append(candidates, coreT.SettlementCost)
+	}
+	candidates = append(candidates, coreT.DevelopmentCardCost)
+
+	best := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if missing(candidate, v.hand) < missing(best, v.hand) {
+			best = candidate
+		}
+	}
+	return best
+}
+
+// neededResource is the resource the hand lacks the most for the goal, breaking ties by lowest production
+func (v *view) neededResource(hand coreT.Resources) coreT.Resource {
+	goal := v.goal()
+	best := core.ResourcesOrder[0]
+	bestMissing := -1
+	for _, resource := range core.ResourcesOrder {
+		resourceMissing := goal.Get(resource) - hand.Get(resource)
+		if resourceMissing > bestMissing || (resourceMissing == bestMissing && v.production.Get(resource) < v.production.Get(best)) {
+			best = resource
+			bestMissing = resourceMissing
+		}
+	}
+	return best
+}
+
+// leader is the opponent with most public points
+func (v *view) leader() string {
+	leader := ""
+	points := v.game.PublicPoints()
+	for _, player := range v.game.Players() {
+		if player.ID == v.playerID {
+			continue
+		}
+		if leader == "" || points[player.ID] > points[leader] {
+			leader = player.ID
+		}
+	}
+	return leader
+}
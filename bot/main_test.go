@@ -0,0 +1,74 @@
+package bot
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/victoroliveirab/settlers/core"
+	mapsdefinitions "github.com/victoroliveirab/settlers/core/maps"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func createGame(t *testing.T, seed int64) *core.GameState {
+	mapsdefinitions.LoadMap("base4")
+	players := make([]*coreT.Player, 4)
+	for i := range players {
+		players[i] = &coreT.Player{ID: strconv.Itoa(i + 1)}
+	}
+	var game core.GameState
+	err := game.NewWithSeed(players, "base4", seed, core.Params{
+		BankTradeAmount:      4,
+		MaxCards:             7,
+		MaxSettlements:       5,
+		MaxCities:            4,
+		MaxRoads:             15,
+		MaxDevCardsPerRound:  1,
+		TargetPoint:          10,
+		PointsPerSettlement:  1,
+		PointsPerCity:        2,
+		PointsForMostKnights: 2,
+		PointsForLongestRoad: 2,
+		LongestRoadMinimum:   5,
+		MostKnightsMinimum:   3,
+	})
+	if err != nil {
+		t.Fatalf("expected to create game just fine, but actually got error %s", err.Error())
+	}
+	game.SetStrictMode(true)
+	return &game
+}
+
+// playGame lets bots play every seat until the game is over
+func playGame(t *testing.T, game *core.GameState, bots map[string]Bot) {
+	_, err := Play(game, bots, 5000)
+	if err != nil {
+		t.Fatalf("expected bots to finish the game, but actually got error %s", err.Error())
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, difficulty := range Difficulties {
+		_, err := New(difficulty, time.Millisecond, 1)
+		if err != nil {
+			t.Errorf("expected to create %s bot just fine, but actually got error %s", difficulty, err.Error())
+		}
+	}
+
+	_, err := New("impossible", time.Millisecond, 1)
+	if err == nil {
+		t.Errorf("expected to have error since difficulty is unknown, but actually no error was found")
+	}
+}
+
+func TestEveryDifficultyPlaysFullGame(t *testing.T) {
+	bots := make(map[string]Bot)
+	for i, difficulty := range []Difficulty{Easy, Normal, Hard, Easy} {
+		bot, err := New(difficulty, time.Millisecond, int64(i+1))
+		if err != nil {
+			t.Fatalf("expected to create %s bot just fine, but actually got error %s", difficulty, err.Error())
+		}
+		bots[strconv.Itoa(i+1)] = bot
+	}
+	playGame(t, createGame(t, 42), bots)
+}
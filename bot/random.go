@@ -0,0 +1,64 @@
+package bot
+
+import (
+	"math/rand"
+
+	"github.com/victoroliveirab/settlers/core"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Random picks any legal action, except trading with the bank or ports
+type Random struct {
+	rand *rand.Rand
+}
+
+func NewRandom(seed int64) *Random {
+	return &Random{rand: rand.New(rand.NewSource(seed))}
+}
+
+func (bot *Random) NextAction(game *core.GameState, playerID string) (core.Command, bool) {
+	pending := pendingTrade(game, playerID)
+	candidates := make([]core.LegalAction, 0)
+	for _, action := range game.LegalActions(playerID) {
+		switch action.Type {
+		case core.CommandDiscardPlayerCards:
+			command := action.Command
+			command.Resources = bot.discard(game.ResourceHandByPlayer(playerID), action.DiscardAmount)
+			return command, true
+		case core.CommandPickGoldResources:
+			command := action.Command
+			// Picking from the bank is just like discarding from its pile of cards
+			command.Resources = bot.discard(game.BankResources(), action.GoldPickAmount)
+			return command, true
+		case core.CommandMakeBankTrade, core.CommandMakeGeneralPortTrade, core.CommandMakeResourcePortTrade:
+			continue
+		case core.CommandAcceptTradeOffer, core.CommandRejectTradeOffer:
+			if pending == nil || action.TradeID != pending.ID {
+				continue
+			}
+		case core.CommandCancelTradeOffer, core.CommandFinalizeTrade:
+			continue
+		}
+		candidates = append(candidates, action)
+	}
+	if len(candidates) == 0 {
+		return core.Command{}, false
+	}
+	return candidates[bot.rand.Intn(len(candidates))].Command, true
+}
+
+func (bot *Random) discard(hand coreT.Resources, amount int) coreT.Resources {
+	cards := make([]coreT.Resource, 0)
+	for _, resource := range core.ResourcesOrder {
+		for i := 0; i < hand.Get(resource); i++ {
+			cards = append(cards, resource)
+		}
+	}
+	bot.rand.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+
+	discarded := coreT.Resources{}
+	for _, resource := range cards[:min(amount, len(cards))] {
+		discarded = discarded.Add(coreT.ResourcesOf(resource, 1))
+	}
+	return discarded
+}
@@ -0,0 +1,161 @@
+package bot
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/victoroliveirab/settlers/core"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+)
+
+// Number of commands simulated after a candidate action before the position is evaluated
+const rolloutDepth = 200
+
+// Rollout searches its own turn decisions with Monte Carlo rollouts over clones of the game:
+// candidates are picked by UCB1 and every player is then simulated by the heuristic bot.
+// Discards, gold picks and trade responses are left to the heuristic bot.
+type Rollout struct {
+	budget time.Duration
+	policy *Heuristic
+	rand   *rand.Rand
+}
+
+func NewRollout(budget time.Duration, seed int64) *Rollout {
+	return &Rollout{
+		budget: budget,
+		policy: NewHeuristic(),
+		rand:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+type candidateStats struct {
+	visits int
+	total  float64
+}
+
+func (bot *Rollout) NextAction(game *core.GameState, playerID string) (core.Command, bool) {
+	suggestion, ok := bot.policy.NextAction(game, playerID)
+	if !ok {
+		return suggestion, false
+	}
+	if !game.IsPlayerTurn(playerID) || suggestion.Type == core.CommandDiscardPlayerCards || suggestion.Type == core.CommandPickGoldResources ||
+		suggestion.Type == core.CommandAcceptTradeOffer || suggestion.Type == core.CommandRejectTradeOffer {
+		return suggestion, true
+	}
+
+	candidates := bot.candidates(game, playerID, suggestion)
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	stats := make([]candidateStats, len(candidates))
+	deadline := time.Now().Add(bot.budget)
+	for iteration := 1; iteration == 1 || time.Now().Before(deadline); iteration++ {
+		index := selectCandidate(stats, iteration)
+		stats[index].visits++
+		stats[index].total += bot.simulate(game, playerID, candidates[index])
+	}
+
+	// Most visited is the most robust choice. Suggestion is first, so it wins ties
+	best := 0
+	for i := range stats {
+		if stats[i].visits > stats[best].visits {
+			best = i
+		}
+	}
+	return candidates[best], true
+}
+
+// candidates lists the suggestion followed by every other legal action but bank and port trades,
+// which are too many to search and are only considered when suggested
+func (bot *Rollout) candidates(game *core.GameState, playerID string, suggestion core.Command) []core.Command {
+	candidates := []core.Command{suggestion}
+	for _, action := range game.LegalActions(playerID) {
+		switch action.Type {
+		case core.CommandMakeBankTrade, core.CommandMakeGeneralPortTrade, core.CommandMakeResourcePortTrade,
+			core.CommandAcceptTradeOffer, core.CommandRejectTradeOffer, core.CommandCancelTradeOffer, core.CommandFinalizeTrade:
+			continue
+		}
+		if sameCommand(action.Command, suggestion) {
+			continue
+		}
+		candidates = append(candidates, action.Command)
+	}
+	return candidates
+}
+
+func sameCommand(a, b core.Command) bool {
+	return a.Type == b.Type && a.VertexID == b.VertexID && a.EdgeID == b.EdgeID && a.TileID == b.TileID &&
+		a.TargetPlayerID == b.TargetPlayerID && a.DevelopmentCard == b.DevelopmentCard &&
+		a.Resource == b.Resource && a.SecondResource == b.SecondResource
+}
+
+// selectCandidate visits every candidate once, then balances exploration and exploitation with UCB1
+func selectCandidate(stats []candidateStats, iteration int) int {
+	best := 0
+	bestScore := math.Inf(-1)
+	for i := range stats {
+		if stats[i].visits == 0 {
+			return i
+		}
+		mean := stats[i].total / float64(stats[i].visits)
+		score := mean + math.Sqrt2*math.Sqrt(math.Log(float64(iteration))/float64(stats[i].visits))
+		if score > bestScore {
+			best = i
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// simulate applies command on a clone with fresh dice, lets the heuristic bot play every seat
+// and evaluates the final position for playerID between 0 (lost) and 1 (won)
+func (bot *Rollout) simulate(game *core.GameState, playerID string, command core.Command) float64 {
+	clone := game.CloneWithSeed(bot.rand.Int63() + 1)
+	// Rejected commands end the rollout anyway
+	clone.SetStrictMode(false)
+	if err := clone.ApplyCommand(command); err != nil {
+		return 0
+	}
+
+	players := clone.Players()
+	for step := 0; step < rolloutDepth && clone.RoundType() != round.GameOver; step++ {
+		acted := false
+		for _, player := range players {
+			next, ok := bot.policy.NextAction(clone, player.ID)
+			if !ok {
+				continue
+			}
+			// A rejected command ends the rollout where it stands
+			if err := clone.ApplyCommand(next); err == nil {
+				acted = true
+			}
+			break
+		}
+		if !acted {
+			break
+		}
+	}
+
+	return evaluate(clone, playerID)
+}
+
+func evaluate(game *core.GameState, playerID string) float64 {
+	points := game.Points()
+	target := float64(game.GetSettings().TargetPoint)
+	bestOpponent := 0
+	for id, quantity := range points {
+		if id != playerID {
+			bestOpponent = max(bestOpponent, quantity)
+		}
+	}
+	if game.RoundType() == round.GameOver {
+		if points[playerID] > bestOpponent {
+			return 1
+		}
+		return 0
+	}
+	value := 0.5 + 0.5*float64(points[playerID]-bestOpponent)/target
+	return min(1, max(0, value))
+}
@@ -15,6 +15,7 @@ export const roundTypes = [
   "YearOfPlentyPickResources",
   "DiscardPhase",
   "GameOver",
+  "SpecialBuild",
 ];
 
 export const roundTypesByName = {
@@ -34,6 +35,7 @@ export const roundTypesByName = {
   YearOfPlentyPickResources: 13,
   DiscardPhase: 14,
   GameOver: 15,
+  SpecialBuild: 16,
 };
 
 export const resourcesOrder: SettlersCore.Resource[] = ["Lumber", "Brick", "Sheep", "Grain", "Ore"];
@@ -52,6 +54,7 @@ export const resourceColors = Object.freeze({
   Ore: "#A9A9A9",
   Sheep: "#98FB98",
   Desert: "#878878",
+  Hidden: "#2F3E46",
 } as Record<SettlersCore.TileType, string>);
 
 export const emojis = Object.freeze({
@@ -99,7 +99,7 @@ export default class Base4MapRenderer extends BaseMapRenderer {
         y: point.y - min.y,
       };
       const hexagon = this.drawHexagon(center, hexSize, tile.id, tile.resource);
-      if (tile.resource !== "Desert") {
+      if (tile.resource !== "Desert" && !tile.hidden) {
         const token = this.drawNumberToken(center, tile.token);
         numberTokensLayer.append(token);
       }
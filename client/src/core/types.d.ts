@@ -6,9 +6,10 @@ declare namespace SettlersCore {
     | "Year of Plenty"
     | "Road Building"
     | "Monopoly";
-  export type TileType = Resource | "Desert";
+  export type TileType = Resource | "Desert" | "Hidden";
   export type Tile = {
     blocked: boolean;
+    hidden?: boolean;
     id: number;
     resource: TileType;
     token: number;
@@ -32,6 +33,7 @@ declare namespace SettlersCore {
   };
   export type Participant = {
     bot: boolean;
+    botDifficulty?: "easy" | "normal" | "hard";
     player: Player | null;
     ready: boolean;
   };
@@ -43,6 +45,7 @@ declare namespace SettlersCore {
   export type Settlements = Record<Building["id"], Building>;
   export type Cities = Record<Building["id"], Building>;
   export type Roads = Record<Building["id"], Building>;
+  export type Ships = Record<Building["id"], Building>;
   export type Hand = ResourceCollection;
   export type DevHand = Record<DevelopmentCard, number>;
 }
@@ -0,0 +1,18 @@
+import { GameCard } from "@/components/custom/game-card";
+import { resourcesOrder } from "@/core/constants";
+
+import { useMatchStore } from "@/state/match";
+
+export const Bank = () => {
+  const bank = useMatchStore((state) => state.bank);
+  return (
+    <ul className="rounded-lg p-1 bg-neutral-100 select-none flex items-center gap-2">
+      {resourcesOrder.map((resource) => (
+        <li key={resource} className="flex items-center gap-1">
+          <GameCard size="xs" value={resource} />
+          <span className="text-sm text-neutral-800">{bank[resource]}</span>
+        </li>
+      ))}
+    </ul>
+  );
+};
@@ -29,6 +29,7 @@ export const SettlersMap = () => {
   const map = useMatchStore((state) => state.map);
   const ports = useMatchStore((state) => state.ports);
   const players = useMatchStore((state) => state.players);
+  const neutralPlayers = useMatchStore((state) => state.neutralPlayers);
 
   const onEdgeClick = useOnEdgeClick();
   const onTileClick = useOnTileClick();
@@ -39,7 +40,7 @@ export const SettlersMap = () => {
     const renderer = mapRendererFactory(
       mapName,
       ref.current,
-      players.reduce(
+      [...players, ...neutralPlayers].reduce(
         (acc, player) => ({
           ...acc,
           [player.name]: player.color,
@@ -1,6 +1,7 @@
 import { Toaster } from "@/components/custom/toaster";
 
 import { ActiveTrades } from "./components/active-trades";
+import { Bank } from "./components/bank";
 import { DevHand } from "./components/dev-hand";
 import { Hand } from "./components/hand";
 import { SettlersMap } from "./components/map";
@@ -26,6 +27,9 @@ export const MainArea = () => {
         <div className="absolute top-0 left-0">
           <Statistics />
         </div>
+        <div className="absolute bottom-0 right-0">
+          <Bank />
+        </div>
       </div>
       <hr className="w-full h-px bg-border" />
       <div className="h-20 flex">
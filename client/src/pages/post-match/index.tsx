@@ -22,7 +22,10 @@ export const PostMatch = () => {
       <Card className="w-full overflow-hidden">
         <CardHeader>
           <CardTitle>Room #{state.roomName}</CardTitle>
-          <CardDescription>4-player game, base map</CardDescription>
+          <CardDescription>
+            4-player game, base map
+            {state.statistics.diceMode && `, ${state.statistics.diceMode.toLowerCase()} dice`}
+          </CardDescription>
         </CardHeader>
         <CardContent className="flex gap-4 justify-center max-h-[50vh]">
           <Statistics
@@ -33,7 +36,13 @@ export const PostMatch = () => {
           />
         </CardContent>
         <CardFooter className="mt-8 flex items-center justify-end gap-2">
-          <Button>Share</Button>
+          <Button
+            disabled={!state.boardCode}
+            onClick={() => navigator.clipboard.writeText(state.boardCode)}
+            title="Copy the board code to play this board again"
+          >
+            Share
+          </Button>
           <Button>Back to Lobby</Button>
         </CardFooter>
       </Card>
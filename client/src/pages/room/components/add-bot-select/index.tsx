@@ -0,0 +1,45 @@
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+
+import { useWebSocket } from "@/hooks/useWebSocket";
+import { usePlayerStore } from "@/state/player";
+import { useRoomStore } from "@/state/room";
+
+const difficulties = ["easy", "normal", "hard"] as const;
+
+export const AddBotSelect = () => {
+  const room = useRoomStore((state) => state.room);
+  const currentUsername = usePlayerStore((state) => state.username);
+  const { sendMessage } = useWebSocket();
+
+  const isEnabled =
+    currentUsername === room.owner && room.participants.some((entry) => !entry.player);
+
+  const onChange = (value: (typeof difficulties)[number]) => {
+    sendMessage({ type: "room.add-bot", payload: { difficulty: value } });
+  };
+
+  return (
+    <Select
+      value=""
+      disabled={!isEnabled}
+      onValueChange={(value) => onChange(value as (typeof difficulties)[number])}
+    >
+      <SelectTrigger>
+        <SelectValue placeholder="-" />
+      </SelectTrigger>
+      <SelectContent>
+        {difficulties.map((difficulty) => (
+          <SelectItem key={difficulty} value={difficulty}>
+            {difficulty}
+          </SelectItem>
+        ))}
+      </SelectContent>
+    </Select>
+  );
+};
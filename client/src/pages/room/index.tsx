@@ -15,6 +15,7 @@ import { RoomParameters } from "./components/room-parameters";
 import { ToggleReady } from "./components/toggle-ready";
 import { StartButton } from "./components/button-start";
 import { RoomCapacitySelect } from "./components/room-capacity-select";
+import { AddBotSelect } from "./components/add-bot-select";
 
 export const Room = () => {
   const room = useRoomStore((state) => state.room);
@@ -34,6 +35,10 @@ export const Room = () => {
               <p>Room Capacity:</p>
               <RoomCapacitySelect />
             </div>
+            <div className="flex items-center justify-between">
+              <p>Add Bot:</p>
+              <AddBotSelect />
+            </div>
           </div>
           <div className="flex-auto basis-0">
             <ScrollArea className="h-full max-h-full">
@@ -1,6 +1,7 @@
 import { create } from "zustand";
 
 type Statistics = {
+  diceMode: string;
   diceStatsByPlayer: Record<SettlersCore.Player["name"], Record<number, number>>;
   generalDiceStats: Record<number, number>;
   longestRoadEvolution: Record<SettlersCore.Player["name"], number[]>;
@@ -9,6 +10,7 @@ type Statistics = {
 };
 
 type MatchReportState = {
+  boardCode: string;
   endDatetime: string;
   pointsDistribution: Record<SettlersCore.Player["name"], Record<string, number>> | null;
   roundsPlayed: number;
@@ -19,10 +21,12 @@ type MatchReportState = {
 
 export const useMatchReportStore = create<MatchReportState>(() => ({
   roomName: "",
+  boardCode: "",
   endDatetime: "",
   pointsDistribution: null,
   roundsPlayed: 0,
   statistics: {
+    diceMode: "",
     diceStatsByPlayer: {},
     generalDiceStats: {},
     longestRoadEvolution: {},
@@ -55,3 +59,7 @@ export const setRoundsPlayed = (value: number) => {
 export const setRoomName = (value: string) => {
   return useMatchReportStore.setState({ roomName: value });
 };
+
+export const setBoardCode = (value: string) => {
+  return useMatchReportStore.setState({ boardCode: value });
+};
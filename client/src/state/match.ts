@@ -23,6 +23,7 @@ type MatchState = {
     status: "Open" | "Closed";
     timestamp: number;
   }[];
+  bank: SettlersCore.ResourceCollection;
   blockedTiles: number[];
   cities: SettlersCore.Cities;
   currentRoundPlayer: {
@@ -42,6 +43,10 @@ type MatchState = {
     discardAmounts: Record<SettlersCore.Player["name"], number>;
     enabled: boolean;
   };
+  goldPick: {
+    enabled: boolean;
+    goldPickAmounts: Record<SettlersCore.Player["name"], number>;
+  };
   edges: {
     available: number[];
     enabled: boolean;
@@ -56,12 +61,20 @@ type MatchState = {
   monopoly: {
     enabled: boolean;
   };
+  neutralPlayers: SettlersCore.Player[];
   ownedPorts: SettlersCore.PortType[];
   points: QuantityByPlayer;
   ports: SettlersCore.Ports;
   players: SettlersCore.Player[];
   resourceCount: QuantityByPlayer;
   roads: SettlersCore.Roads;
+  seaTiles: SettlersCore.Map;
+  ships: SettlersCore.Ships;
+  shipEdges: {
+    available: number[];
+    enabled: boolean;
+    movable: number[];
+  };
   robbablePlayers: {
     enabled: boolean;
     options: SettlersCore.Player["name"][] | null;
@@ -72,6 +85,10 @@ type MatchState = {
     highlight: boolean;
   };
   settlements: SettlersCore.Settlements;
+  tradeTokens: {
+    cost: number;
+    quantityByPlayer: QuantityByPlayer;
+  };
   vertices: {
     availableForCity: number[];
     availableForSettlement: number[];
@@ -90,6 +107,13 @@ export const useMatchStore = create<MatchState>(() => ({
     trade: false,
   },
   activeTradeOffers: [],
+  bank: {
+    Lumber: 0,
+    Brick: 0,
+    Sheep: 0,
+    Grain: 0,
+    Ore: 0,
+  },
   blockedTiles: [],
   cities: {},
   currentRoundPlayer: null,
@@ -116,6 +140,10 @@ export const useMatchStore = create<MatchState>(() => ({
     discardAmounts: {},
     enabled: false,
   },
+  goldPick: {
+    enabled: false,
+    goldPickAmounts: {},
+  },
   edges: {
     available: [],
     enabled: false,
@@ -139,6 +167,13 @@ export const useMatchStore = create<MatchState>(() => ({
   ownedPorts: [],
   points: {},
   roads: {},
+  seaTiles: [],
+  ships: {},
+  shipEdges: {
+    available: [],
+    enabled: false,
+    movable: [],
+  },
   robber: {
     availableTiles: [],
     enabled: false,
@@ -149,7 +184,12 @@ export const useMatchStore = create<MatchState>(() => ({
     options: [],
   },
   settlements: {},
+  tradeTokens: {
+    cost: 0,
+    quantityByPlayer: {},
+  },
   ports: [],
+  neutralPlayers: [],
   players: [],
   resourceCount: {},
   vertices: {
@@ -264,6 +304,18 @@ export const setRoads = (value: SettlersCore.Roads) => {
   return useMatchStore.setState({ roads: value });
 };
 
+export const setSeaTiles = (value: SettlersCore.Map) => {
+  return useMatchStore.setState({ seaTiles: value });
+};
+
+export const setShips = (value: SettlersCore.Ships) => {
+  return useMatchStore.setState({ ships: value });
+};
+
+export const setShipEdges = (value: MatchState["shipEdges"]) => {
+  return useMatchStore.setState({ shipEdges: value });
+};
+
 export const setSettlements = (value: SettlersCore.Settlements) => {
   return useMatchStore.setState({ settlements: value });
 };
@@ -276,10 +328,22 @@ export const setPlayers = (value: SettlersCore.Player[]) => {
   return useMatchStore.setState({ players: value });
 };
 
+export const setNeutralPlayers = (value: SettlersCore.Player[]) => {
+  return useMatchStore.setState({ neutralPlayers: value });
+};
+
+export const setTradeTokens = (value: MatchState["tradeTokens"]) => {
+  return useMatchStore.setState({ tradeTokens: value });
+};
+
 export const setResourceCount = (value: Record<string, number>) => {
   return useMatchStore.setState({ resourceCount: value });
 };
 
+export const setBank = (value: SettlersCore.ResourceCollection) => {
+  return useMatchStore.setState({ bank: value });
+};
+
 export const setDevHandCount = (value: Record<string, number>) => {
   return useMatchStore.setState({ devHandCount: value });
 };
@@ -325,6 +389,10 @@ export const setDiscard = (amounts: Record<string, number>, enabled: boolean) =>
   });
 };
 
+export const setGoldPick = (value: MatchState["goldPick"]) => {
+  return useMatchStore.setState({ goldPick: value });
+};
+
 export const setRobber = (value: MatchState["robber"]) => {
   return useMatchStore.setState({
     robber: value,
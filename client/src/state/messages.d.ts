@@ -22,14 +22,34 @@ export namespace SettlersRoom {
       room: SettlersServer.Room;
       params: SettlersServer.RoomParam[];
     };
+    "room.add-bot.success": {
+      room: SettlersServer.Room;
+      params: SettlersServer.RoomParam[];
+    };
+    "room.set-scenario.success": {
+      room: SettlersServer.Room;
+      params: SettlersServer.RoomParam[];
+    };
     "room.start-game.success": {
+      // Missing with fog of war
+      balance?: {
+        adjacentRedNumbers: number;
+        adjacentSameNumbers: number;
+        largestClusters: Record<SettlersCore.Resource, number>;
+        pipsByResource: Record<SettlersCore.Resource, number>;
+        pipVariance: number;
+        score: number;
+      };
+      bank: SettlersCore.ResourceCollection;
       logs: string[];
       map: SettlersCore.Map;
       mapName: string;
+      neutralPlayers: SettlersCore.Player[];
       players: SettlersCore.Player[];
       ports: SettlersCore.Ports;
       resourceCount: Record<SettlersCore.Player["name"], number>;
       roomStatus: string;
+      seaTiles: SettlersCore.Map;
     };
   };
 
@@ -48,6 +68,12 @@ export namespace SettlersRoom {
       roomID: string;
       ready: boolean;
     };
+    "room.add-bot": {
+      difficulty: "easy" | "normal" | "hard";
+    };
+    "room.set-scenario": {
+      scenario: object | null;
+    };
     "room.start-game": {};
   };
 
@@ -80,11 +106,18 @@ export namespace SettlersMatch {
       enabled: boolean;
       highlight: boolean;
     };
+    "setup.update-ships": {
+      availableEdges: number[];
+      enabled: boolean;
+      movableShips: number[];
+    };
     "setup.update-map": {
       blockedTiles: number[];
       cities: SettlersCore.Cities;
       roads: SettlersCore.Roads;
       settlements: SettlersCore.Settlements;
+      ships: SettlersCore.Ships;
+      tiles: SettlersCore.Map;
     };
     "setup.update-ports": {
       ports: SettlersCore.PortType[];
@@ -95,6 +128,9 @@ export namespace SettlersMatch {
     "setup.update-points": {
       points: Record<SettlersCore.Player["name"], number>;
     };
+    "setup.update-bank": {
+      bank: SettlersCore.ResourceCollection;
+    };
     "setup.update-logs": string[];
 
     "match.update-round-player": {
@@ -114,11 +150,18 @@ export namespace SettlersMatch {
       enabled: boolean;
       highlight: boolean;
     };
+    "match.update-ships": {
+      availableEdges: number[];
+      enabled: boolean;
+      movableShips: number[];
+    };
     "match.update-map": {
       blockedTiles: number[];
       cities: SettlersCore.Cities;
       roads: SettlersCore.Roads;
       settlements: SettlersCore.Settlements;
+      ships: SettlersCore.Ships;
+      tiles: SettlersCore.Map;
     };
     "match.update-ports": {
       ports: SettlersCore.PortType[];
@@ -126,6 +169,9 @@ export namespace SettlersMatch {
     "match.update-resource-count": {
       resourceCount: Record<SettlersCore.Player["name"], number>;
     };
+    "match.update-bank": {
+      bank: SettlersCore.ResourceCollection;
+    };
     "match.update-dev-hand-count": {
       devHandCount: Record<SettlersCore.Player["name"], number>;
     };
@@ -165,6 +211,10 @@ export namespace SettlersMatch {
       discardAmounts: Record<SettlersCore.Player["name"], number>;
       enabled: boolean;
     };
+    "match.update-gold-pick": {
+      enabled: boolean;
+      goldPickAmounts: Record<SettlersCore.Player["name"], number>;
+    };
     "match.update-trade-offers": {
       offers: {
         creator: SettlersCore.Player["name"];
@@ -199,6 +249,10 @@ export namespace SettlersMatch {
     "match.update-year-of-plenty": {
       enabled: boolean;
     };
+    "match.update-trade-tokens": {
+      cost: number;
+      tradeTokens: Record<SettlersCore.Player["name"], number>;
+    };
     "match.update-monopoly": {
       enabled: boolean;
     };
@@ -206,6 +260,7 @@ export namespace SettlersMatch {
       report: {
         pointsDistribution: null;
         statistics: {
+          diceMode: string;
           diceStatsByPlayer: Record<SettlersCore.Player["name"], Record<number, number>>;
           generalDiceStats: Record<number, number>;
           longestRoadEvolution: Record<SettlersCore.Player["name"], number[]>;
@@ -219,6 +274,7 @@ export namespace SettlersMatch {
       report: {
         pointsDistribution: Record<SettlersCore.Player["name"], Record<string, number>>;
         statistics: {
+          diceMode: string;
           diceStatsByPlayer: Record<SettlersCore.Player["name"], Record<number, number>>;
           generalDiceStats: Record<number, number>;
           longestRoadEvolution: Record<SettlersCore.Player["name"], number[]>;
@@ -230,11 +286,13 @@ export namespace SettlersMatch {
       roundsPlayed: number;
       startDatetime: string;
       endDatetime: string;
+      boardCode: string;
     };
     "over.hydrate": {
       report: {
         pointsDistribution: Record<SettlersCore.Player["name"], Record<string, number>>;
         statistics: {
+          diceMode: string;
           diceStatsByPlayer: Record<SettlersCore.Player["name"], Record<number, number>>;
           generalDiceStats: Record<number, number>;
           longestRoadEvolution: Record<SettlersCore.Player["name"], number[]>;
@@ -249,6 +307,7 @@ export namespace SettlersMatch {
       roomName: string;
       players: SettlersCore.Player[];
       mapName: string;
+      boardCode: string;
     };
   };
 
@@ -260,6 +319,13 @@ export namespace SettlersMatch {
     "match.edge-click": {
       edge: number;
     };
+    "match.ship-click": {
+      edge: number;
+    };
+    "match.move-ship": {
+      from: number;
+      to: number;
+    };
     "match.tile-click": {
       tile: number;
     };
@@ -270,6 +336,9 @@ export namespace SettlersMatch {
     "match.discard-cards": {
       resources: SettlersCore.ResourceCollection;
     };
+    "match.pick-gold": {
+      resources: SettlersCore.ResourceCollection;
+    };
     "match.make-bank-trade": {
       given: SettlersCore.ResourceCollection;
       requested: SettlersCore.ResourceCollection;
@@ -315,6 +384,11 @@ export namespace SettlersMatch {
     "match.monopoly": {
       resource: SettlersCore.Resource;
     };
+    "match.force-trade": {
+      given: SettlersCore.ResourceCollection;
+      player: SettlersCore.Player["name"];
+    };
+    "match.robber-to-desert": {};
     "match.end-round": {};
     "match.report": {};
   };
@@ -327,16 +401,19 @@ export namespace SettlersMatch {
   export type MatchSetupHydrateMessage = {
     type: "setup.hydrate";
     payload: {
+      bank: SettlersCore.ResourceCollection;
       devHandCount: Record<SettlersCore.Player["name"], number>;
       edgeUpdate: SingleIncomingMessage<"setup.update-edges">;
       map: SettlersCore.Map;
       mapName: string;
       mapUpdate: SingleIncomingMessage<"setup.update-map">;
+      neutralPlayers: SettlersCore.Player[];
       players: SettlersCore.Player[];
       ports: SettlersCore.Ports;
       resourceCount: Record<SettlersCore.Player["name"], number>;
       roundPlayerUpdate: SingleIncomingMessage<"setup.update-round-player">;
       roomStatus: string;
+      seaTiles: SettlersCore.Map;
       vertexUpdate: SingleIncomingMessage<"setup.update-vertices">;
     };
   };
@@ -344,12 +421,14 @@ export namespace SettlersMatch {
   export type MatchHydrateMessage = {
     type: "match.hydrate";
     payload: {
+      bank: SettlersCore.ResourceCollection;
       buyDevCardUpdate: SingleIncomingMessage<"match.update-buy-dev-card">;
       devHandCount: Record<SettlersCore.Player["name"], number>;
       devHandUpdate: SingleIncomingMessage<"match.update-dev-hand">;
       devHandPermissionsUpdate: SingleIncomingMessage<"match.update-dev-hand-permissions">;
       diceUpdate: SingleIncomingMessage<"match.update-dice">;
       discardUpdate: SingleIncomingMessage<"match.update-discard-phase">;
+      goldPickUpdate: SingleIncomingMessage<"match.update-gold-pick">;
       edgeUpdate: SingleIncomingMessage<"match.update-edges">;
       handUpdate: SingleIncomingMessage<"match.update-hand">;
       knightsUsageUpdate: SingleIncomingMessage<"match.update-knight-usage">;
@@ -357,6 +436,7 @@ export namespace SettlersMatch {
       map: SettlersCore.Map;
       mapName: string;
       mapUpdate: SingleIncomingMessage<"match.update-map">;
+      neutralPlayers: SettlersCore.Player[];
       passActionState: SingleIncomingMessage<"match.update-pass">;
       players: SettlersCore.Player[];
       pointsUpdate: SingleIncomingMessage<"match.update-points">;
@@ -367,8 +447,11 @@ export namespace SettlersMatch {
       robberMovementUpdate: SingleIncomingMessage<"match.update-robber-movement">;
       roomStatus: string;
       roundPlayerUpdate: SingleIncomingMessage<"match.update-round-player">;
+      seaTiles: SettlersCore.Map;
+      shipUpdate: SingleIncomingMessage<"match.update-ships">;
       tradeActionState: SingleIncomingMessage<"match.update-trade">;
       tradeOffersUpdate: SingleIncomingMessage<"match.update-trade-offers">;
+      tradeTokensUpdate: SingleIncomingMessage<"match.update-trade-tokens">;
       vertexUpdate: SingleIncomingMessage<"match.update-vertices">;
       yearOfPlentyUpdate: SingleIncomingMessage<"match.update-year-of-plenty">;
     };
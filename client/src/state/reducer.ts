@@ -1,6 +1,7 @@
 import { toast } from "@/lib/toast";
 import {
   setActiveTradeOffers,
+  setBank,
   setBlockedTiles,
   setBuyDevCardAction,
   setCities,
@@ -11,6 +12,7 @@ import {
   setDice,
   setDiscard,
   setEdges,
+  setGoldPick,
   setHand,
   setKnightUsages,
   setLogs,
@@ -18,6 +20,7 @@ import {
   setMap,
   setMapName,
   setMonopoly,
+  setNeutralPlayers,
   setPassAction,
   setPlayerPorts,
   setPlayers,
@@ -27,12 +30,17 @@ import {
   setRoads,
   setRobbablePlayers,
   setRobber,
+  setSeaTiles,
   setSettlements,
+  setShipEdges,
+  setShips,
   setTradeAction,
+  setTradeTokens,
   setVertices,
   setYearOfPlenty,
 } from "./match";
 import {
+  setBoardCode,
   setEndDatetime,
   setPointsDistribution,
   setRoomName,
@@ -88,12 +96,27 @@ export function reducer(message: SettlersIncomingMessage) {
       setRoomParams(message.payload.params);
       break;
     }
+    case "room.add-bot.success": {
+      setRoom(message.payload.room);
+      setRoomName(message.payload.room.id);
+      setRoomParams(message.payload.params);
+      break;
+    }
+    case "room.set-scenario.success": {
+      setRoom(message.payload.room);
+      setRoomName(message.payload.room.id);
+      setRoomParams(message.payload.params);
+      break;
+    }
     case "room.start-game.success": {
       setRoomStatus(message.payload.roomStatus);
       setMapName(message.payload.mapName);
       setMap(message.payload.map);
+      setSeaTiles(message.payload.seaTiles);
+      setNeutralPlayers(message.payload.neutralPlayers);
       setPlayers(message.payload.players);
       setPorts(message.payload.ports);
+      setBank(message.payload.bank);
       break;
     }
     case "setup.update-map":
@@ -101,7 +124,9 @@ export function reducer(message: SettlersIncomingMessage) {
       setCities(message.payload.cities);
       setRoads(message.payload.roads);
       setSettlements(message.payload.settlements);
+      setShips(message.payload.ships);
       setBlockedTiles(message.payload.blockedTiles);
+      setMap(message.payload.tiles);
       break;
     }
     case "setup.update-edges":
@@ -109,6 +134,15 @@ export function reducer(message: SettlersIncomingMessage) {
       setEdges(message.payload.availableEdges, message.payload.highlight, message.payload.enabled);
       break;
     }
+    case "setup.update-ships":
+    case "match.update-ships": {
+      setShipEdges({
+        available: message.payload.availableEdges,
+        enabled: message.payload.enabled,
+        movable: message.payload.movableShips,
+      });
+      break;
+    }
     case "setup.update-vertices":
     case "match.update-vertices": {
       setVertices(
@@ -144,6 +178,11 @@ export function reducer(message: SettlersIncomingMessage) {
       setResourceCount(message.payload.resourceCount);
       break;
     }
+    case "setup.update-bank":
+    case "match.update-bank": {
+      setBank(message.payload.bank);
+      break;
+    }
     case "match.update-dev-hand-count": {
       setDevHandCount(message.payload.devHandCount);
       break;
@@ -179,6 +218,13 @@ export function reducer(message: SettlersIncomingMessage) {
       setActiveTradeOffers(message.payload.offers);
       break;
     }
+    case "match.update-trade-tokens": {
+      setTradeTokens({
+        cost: message.payload.cost,
+        quantityByPlayer: message.payload.tradeTokens,
+      });
+      break;
+    }
     case "match.update-buy-dev-card": {
       setBuyDevCardAction(message.payload.enabled);
       break;
@@ -195,6 +241,10 @@ export function reducer(message: SettlersIncomingMessage) {
       setDiscard(message.payload.discardAmounts, message.payload.enabled);
       break;
     }
+    case "match.update-gold-pick": {
+      setGoldPick(message.payload);
+      break;
+    }
     case "match.update-robber-movement": {
       setRobber(message.payload);
       break;
@@ -238,13 +288,17 @@ export function reducer(message: SettlersIncomingMessage) {
 
       setMapName(message.payload.mapName);
       setMap(message.payload.map);
+      setSeaTiles(message.payload.seaTiles);
+      setNeutralPlayers(message.payload.neutralPlayers);
       setPlayers(message.payload.players);
       setResourceCount(message.payload.resourceCount);
       setDevHandCount(message.payload.devHandCount);
+      setBank(message.payload.bank);
       setPorts(message.payload.ports);
 
       setRoads(message.payload.mapUpdate.payload.roads);
       setSettlements(message.payload.mapUpdate.payload.settlements);
+      setShips(message.payload.mapUpdate.payload.ships);
       setBlockedTiles(message.payload.mapUpdate.payload.blockedTiles);
       break;
     }
@@ -270,13 +324,21 @@ export function reducer(message: SettlersIncomingMessage) {
 
       setMapName(message.payload.mapName);
       setMap(message.payload.map);
+      setSeaTiles(message.payload.seaTiles);
+      setNeutralPlayers(message.payload.neutralPlayers);
       setPlayers(message.payload.players);
       setPorts(message.payload.ports);
 
       setCities(message.payload.mapUpdate.payload.cities);
       setRoads(message.payload.mapUpdate.payload.roads);
       setSettlements(message.payload.mapUpdate.payload.settlements);
+      setShips(message.payload.mapUpdate.payload.ships);
       setBlockedTiles(message.payload.mapUpdate.payload.blockedTiles);
+      setShipEdges({
+        available: message.payload.shipUpdate.payload.availableEdges,
+        enabled: message.payload.shipUpdate.payload.enabled,
+        movable: message.payload.shipUpdate.payload.movableShips,
+      });
       setPlayerPorts(message.payload.portsUpdate.payload.ports);
 
       setHand(message.payload.handUpdate.payload.hand);
@@ -289,10 +351,15 @@ export function reducer(message: SettlersIncomingMessage) {
       setPassAction(message.payload.passActionState.payload.enabled);
       setTradeAction(message.payload.tradeActionState.payload.enabled);
       setActiveTradeOffers(message.payload.tradeOffersUpdate.payload.offers);
+      setTradeTokens({
+        cost: message.payload.tradeTokensUpdate.payload.cost,
+        quantityByPlayer: message.payload.tradeTokensUpdate.payload.tradeTokens,
+      });
       setDiscard(
         message.payload.discardUpdate.payload.discardAmounts,
         message.payload.discardUpdate.payload.enabled,
       );
+      setGoldPick(message.payload.goldPickUpdate.payload);
       setRobber(message.payload.robberMovementUpdate.payload);
       setRobbablePlayers(message.payload.robbablePlayersUpdate.payload);
       setBuyDevCardAction(message.payload.buyDevCardUpdate.payload.enabled);
@@ -300,6 +367,7 @@ export function reducer(message: SettlersIncomingMessage) {
 
       setResourceCount(message.payload.resourceCount);
       setDevHandCount(message.payload.devHandCount);
+      setBank(message.payload.bank);
       setPoints(message.payload.pointsUpdate.payload.points);
       setLongestRoadSizes(message.payload.longestRoadUpdate.payload.longestRoadSizeByPlayer);
       setKnightUsages(message.payload.knightsUsageUpdate.payload.knightUsesByPlayer);
@@ -315,6 +383,7 @@ export function reducer(message: SettlersIncomingMessage) {
       setStartDatetime(message.payload.startDatetime);
       setEndDatetime(message.payload.endDatetime);
       setRoundsPlayed(message.payload.roundsPlayed);
+      setBoardCode(message.payload.boardCode);
       break;
     }
     case "over.hydrate": {
@@ -327,6 +396,7 @@ export function reducer(message: SettlersIncomingMessage) {
       setStartDatetime(message.payload.startDatetime);
       setEndDatetime(message.payload.endDatetime);
       setRoundsPlayed(message.payload.roundsPlayed);
+      setBoardCode(message.payload.boardCode);
       break;
     }
     case "match.dice-roll.error":
@@ -337,6 +407,7 @@ export function reducer(message: SettlersIncomingMessage) {
     case "match.rob-player.error":
     case "match.buy-dev-card.error":
     case "match.discard-cards.error":
+    case "match.pick-gold.error":
     case "match.dev-card-click.error":
     case "match.year-of-plenty.error":
     case "match.make-bank-trade.error":
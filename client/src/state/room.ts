@@ -12,12 +12,14 @@ type RoomParticipant = {
   } | null;
   ready: boolean;
   bot: boolean;
+  botDifficulty?: "easy" | "normal" | "hard";
 };
 
 type Room = {
   id: string;
   capacity: number;
   map: string;
+  scenario?: { name: string; description: string; map: string };
   participants: RoomParticipant[];
   private: boolean;
   owner: string;
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	mapsdefinitions "github.com/victoroliveirab/settlers/core/maps"
+)
+
+// Validates map JSON files, reporting every inconsistency found.
+// With -topology, also prints the tables derived from the hex coordinates, to help authoring new maps.
+// Example: go run ./cmd/mapcheck core/maps/data/*.json
+
+type topology struct {
+	VerticesByTile map[int][6]int `json:"verticesByTile"`
+	EdgesByTile    map[int][6]int `json:"edgesByTile"`
+	TilesByVertex  map[int][]int  `json:"tilesByVertex"`
+	VerticesByEdge map[int][2]int `json:"verticesByEdge"`
+	EdgesByVertex  map[int][]int  `json:"edgesByVertex"`
+}
+
+func main() {
+	printTopology := flag.Bool("topology", false, "print the topology derived from each valid map")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: mapcheck [-topology] file.json...")
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, path := range flag.Args() {
+		if err := check(path, *printTopology); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", path, err.Error())
+			failed = true
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s: ok\n", path)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func check(path string, printTopology bool) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	definitions, err := mapsdefinitions.ParseMap(content)
+	if err != nil {
+		return err
+	}
+	if !printTopology {
+		return nil
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(topology{
+		VerticesByTile: definitions.VerticesByTile,
+		EdgesByTile:    definitions.EdgesByTile,
+		TilesByVertex:  definitions.TilesByVertex,
+		VerticesByEdge: definitions.VerticesByEdge,
+		EdgesByVertex:  definitions.EdgesByVertex,
+	})
+}
@@ -22,7 +22,9 @@ func main() {
 	}
 	db := turso.Db
 	defer turso.CleanUp()
-	mapsdefinitions.LoadMap("base4")
+	if err := mapsdefinitions.LoadAllMaps(); err != nil {
+		panic(err)
+	}
 	router.SetupRoutes(db)
 	logger.Log("starting ws server")
 	log.Fatal(http.ListenAndServe(":8080", nil))
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/victoroliveirab/settlers/bot"
+	"github.com/victoroliveirab/settlers/core"
+	mapsdefinitions "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/summary"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Plays complete games between bots on the core engine and reports per game and aggregate results.
+// Example: go run ./cmd/simulate -from 1 -to 1000 -params targetPoint=12,longestRoadMinimum=6 -out results.json
+
+type config struct {
+	mapName     string
+	from        int64
+	to          int64
+	seats       []bot.Difficulty
+	budget      time.Duration
+	maxCommands int
+	workers     int
+	strict      bool
+	params      core.Params
+}
+
+type gameResult struct {
+	Seed int64 `json:"seed"`
+	// Index of the winner seat, -1 if the game didn't finish or ended before anyone reached the target
+	Winner   int                               `json:"winner"`
+	Rounds   int                               `json:"rounds"`
+	Commands int                               `json:"commands"`
+	Points   []summary.PlayerPointDistribution `json:"points"`
+	Dice     map[int]int                       `json:"dice"`
+	// Fairness of the board, from 0 to 100
+	BalanceScore float64 `json:"balanceScore"`
+	Error        string  `json:"error,omitempty"`
+	Crashed      bool    `json:"crashed,omitempty"`
+}
+
+type pointSources struct {
+	Total         float64 `json:"total"`
+	Settlements   float64 `json:"settlements"`
+	Cities        float64 `json:"cities"`
+	VictoryPoints float64 `json:"victoryPoints"`
+	LargestArmy   float64 `json:"largestArmy"`
+	LongestRoad   float64 `json:"longestRoad"`
+}
+
+type failure struct {
+	Seed    int64  `json:"seed"`
+	Error   string `json:"error"`
+	Crashed bool   `json:"crashed"`
+}
+
+type aggregate struct {
+	Games    int `json:"games"`
+	Finished int `json:"finished"`
+	Crashed  int `json:"crashed"`
+	Stalled  int `json:"stalled"`
+	// Finished games that ended before anyone reached the target
+	NoWinner      int       `json:"noWinner"`
+	WinsBySeat    []int     `json:"winsBySeat"`
+	WinRateBySeat []float64 `json:"winRateBySeat"`
+	// Win rate of the first seat minus the win rate of a fair game
+	FirstPlayerAdvantage float64 `json:"firstPlayerAdvantage"`
+	AverageRounds        float64 `json:"averageRounds"`
+	MinRounds            int     `json:"minRounds"`
+	MaxRounds            int     `json:"maxRounds"`
+	// Average points by source of the winners and of every player
+	WinnerPoints pointSources `json:"winnerPoints"`
+	PlayerPoints pointSources `json:"playerPoints"`
+	Dice         map[int]int  `json:"dice"`
+	Failures     []failure    `json:"failures"`
+}
+
+type output struct {
+	Map       string           `json:"map"`
+	Seats     []bot.Difficulty `json:"seats"`
+	Params    core.Params      `json:"params"`
+	Games     []gameResult     `json:"games"`
+	Aggregate aggregate        `json:"aggregate"`
+}
+
+// parseParams starts from the map defaults and applies overrides written as key=value,key=value
+func parseParams(mapName, overrides string) (core.Params, error) {
+	metadata, err := mapsdefinitions.GetMetadata(mapName)
+	if err != nil {
+		return core.Params{}, err
+	}
+	values := make(map[string]int)
+	for key, param := range metadata.Params {
+		values[key] = param.Default
+	}
+
+	if overrides != "" {
+		for _, override := range strings.Split(overrides, ",") {
+			key, rawValue, found := strings.Cut(override, "=")
+			if !found {
+				err := fmt.Errorf("Param %s isn't written as key=value", override)
+				return core.Params{}, err
+			}
+			if !slices.Contains(core.ParamsKeys(), key) {
+				err := fmt.Errorf("Unknown param %s. Known params: %s", key, strings.Join(core.ParamsKeys(), ", "))
+				return core.Params{}, err
+			}
+			value, err := strconv.Atoi(rawValue)
+			if err != nil {
+				err := fmt.Errorf("Param %s must be an integer, got %s", key, rawValue)
+				return core.Params{}, err
+			}
+			values[key] = value
+		}
+	}
+	return core.ParamsFromMap(values), nil
+}
+
+func parseSeats(raw string) ([]bot.Difficulty, error) {
+	seats := make([]bot.Difficulty, 0)
+	for _, difficulty := range strings.Split(raw, ",") {
+		seat := bot.Difficulty(strings.TrimSpace(difficulty))
+		if _, err := bot.New(seat, 0, 0); err != nil {
+			return nil, err
+		}
+		seats = append(seats, seat)
+	}
+	return seats, nil
+}
+
+// playGame plays a single game to completion. Panics are reported as crashes instead of stopping the simulation
+func playGame(cfg config, seed int64) (result gameResult) {
+	result = gameResult{Seed: seed, Winner: -1}
+	defer func() {
+		if r := recover(); r != nil {
+			result.Winner = -1
+			result.Crashed = true
+			result.Error = fmt.Sprintf("%v", r)
+		}
+	}()
+
+	players := make([]*coreT.Player, len(cfg.seats))
+	bots := make(map[string]bot.Bot)
+	for i, difficulty := range cfg.seats {
+		id := strconv.Itoa(i + 1)
+		players[i] = &coreT.Player{ID: id}
+		// Errors were ruled out by parseSeats
+		bots[id], _ = bot.New(difficulty, cfg.budget, seed*int64(len(cfg.seats))+int64(i))
+	}
+
+	var game core.GameState
+	if err := game.NewWithSeed(players, cfg.mapName, seed, cfg.params); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	game.SetStrictMode(cfg.strict)
+	result.BalanceScore = game.BoardBalance().Score
+	winnerID := ""
+	game.Subscribe(func(event core.Event) {
+		if event, ok := event.(core.GameEnded); ok {
+			winnerID = event.WinnerID
+		}
+	})
+
+	commands, err := bot.Play(&game, bots, cfg.maxCommands)
+	result.Commands = commands
+	result.Rounds = game.Round()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	for i, player := range players {
+		if player.ID == winnerID {
+			result.Winner = i
+		}
+	}
+	report := game.GetReport()
+	result.Points = make([]summary.PlayerPointDistribution, len(players))
+	for i, player := range players {
+		result.Points[i] = report.PointsDistribution[player.ID]
+	}
+	result.Dice = report.Statistics.GeneralDiceStats
+	return result
+}
+
+func simulate(cfg config) []gameResult {
+	results := make([]gameResult, cfg.to-cfg.from+1)
+	seeds := make(chan int64)
+	var wg sync.WaitGroup
+	for range cfg.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seed := range seeds {
+				results[seed-cfg.from] = playGame(cfg, seed)
+			}
+		}()
+	}
+	for seed := cfg.from; seed <= cfg.to; seed++ {
+		seeds <- seed
+	}
+	close(seeds)
+	wg.Wait()
+	return results
+}
+
+func (sources *pointSources) add(distribution summary.PlayerPointDistribution) {
+	sources.Total += float64(distribution.Total)
+	sources.Settlements += float64(distribution.Settlements)
+	sources.Cities += float64(distribution.Cities)
+	sources.VictoryPoints += float64(distribution.VictoryPoints)
+	sources.LargestArmy += float64(distribution.LargestArmy)
+	sources.LongestRoad += float64(distribution.LongestRoad)
+}
+
+func (sources *pointSources) divide(quantity int) {
+	if quantity == 0 {
+		return
+	}
+	divisor := float64(quantity)
+	sources.Total /= divisor
+	sources.Settlements /= divisor
+	sources.Cities /= divisor
+	sources.VictoryPoints /= divisor
+	sources.LargestArmy /= divisor
+	sources.LongestRoad /= divisor
+}
+
+func summarize(results []gameResult, numberOfSeats int) aggregate {
+	stats := aggregate{
+		Games:         len(results),
+		WinsBySeat:    make([]int, numberOfSeats),
+		WinRateBySeat: make([]float64, numberOfSeats),
+		Dice:          make(map[int]int),
+		Failures:      make([]failure, 0),
+	}
+
+	totalRounds := 0
+	players := 0
+	for _, result := range results {
+		if result.Error != "" {
+			if result.Crashed {
+				stats.Crashed++
+			} else {
+				stats.Stalled++
+			}
+			stats.Failures = append(stats.Failures, failure{Seed: result.Seed, Error: result.Error, Crashed: result.Crashed})
+			continue
+		}
+
+		stats.Finished++
+		totalRounds += result.Rounds
+		if stats.MinRounds == 0 || result.Rounds < stats.MinRounds {
+			stats.MinRounds = result.Rounds
+		}
+		stats.MaxRounds = max(stats.MaxRounds, result.Rounds)
+		if result.Winner == -1 {
+			stats.NoWinner++
+		} else {
+			stats.WinsBySeat[result.Winner]++
+			stats.WinnerPoints.add(result.Points[result.Winner])
+		}
+		for _, distribution := range result.Points {
+			stats.PlayerPoints.add(distribution)
+			players++
+		}
+		for value, quantity := range result.Dice {
+			stats.Dice[value] += quantity
+		}
+	}
+
+	if stats.Finished == 0 {
+		return stats
+	}
+	for seat, wins := range stats.WinsBySeat {
+		stats.WinRateBySeat[seat] = float64(wins) / float64(stats.Finished)
+	}
+	stats.FirstPlayerAdvantage = stats.WinRateBySeat[0] - 1/float64(numberOfSeats)
+	stats.AverageRounds = float64(totalRounds) / float64(stats.Finished)
+	stats.WinnerPoints.divide(stats.Finished - stats.NoWinner)
+	stats.PlayerPoints.divide(players)
+	return stats
+}
+
+func main() {
+	mapName := flag.String("map", "base4", "map to play on")
+	from := flag.Int64("from", 1, "first seed (inclusive)")
+	to := flag.Int64("to", 100, "last seed (inclusive)")
+	seats := flag.String("bots", "normal,normal,normal,normal", "comma separated difficulty of each seat, in playing order")
+	budget := flag.Duration("budget", 20*time.Millisecond, "thinking time per decision of hard bots")
+	maxCommands := flag.Int("max-commands", 5000, "commands after which an unfinished game is reported as stalled")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of games played concurrently")
+	strict := flag.Bool("strict", false, "validate the game state after every command")
+	overrides := flag.String("params", "", "comma separated key=value overrides of the map default params")
+	out := flag.String("out", "", "file to write the results to (default stdout)")
+	flag.Parse()
+
+	if err := run(*mapName, *from, *to, *seats, *budget, *maxCommands, *workers, *strict, *overrides, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(mapName string, from, to int64, rawSeats string, budget time.Duration, maxCommands, workers int, strict bool, overrides, out string) error {
+	if to < from {
+		err := fmt.Errorf("Seed range is empty: %d to %d", from, to)
+		return err
+	}
+	if err := mapsdefinitions.LoadMap(mapName); err != nil {
+		return err
+	}
+	seats, err := parseSeats(rawSeats)
+	if err != nil {
+		return err
+	}
+	metadata, err := mapsdefinitions.GetMetadata(mapName)
+	if err != nil {
+		return err
+	}
+	if len(seats) < metadata.Players.Min || len(seats) > metadata.Players.Max {
+		err := fmt.Errorf("%s is played by %d to %d players, got %d bots", mapName, metadata.Players.Min, metadata.Players.Max, len(seats))
+		return err
+	}
+	params, err := parseParams(mapName, overrides)
+	if err != nil {
+		return err
+	}
+
+	cfg := config{
+		mapName:     mapName,
+		from:        from,
+		to:          to,
+		seats:       seats,
+		budget:      budget,
+		maxCommands: maxCommands,
+		workers:     max(1, workers),
+		strict:      strict,
+		params:      params,
+	}
+	results := simulate(cfg)
+
+	var writer io.Writer = os.Stdout
+	if out != "" {
+		file, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		writer = file
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output{
+		Map:       mapName,
+		Seats:     seats,
+		Params:    params,
+		Games:     results,
+		Aggregate: summarize(results, len(seats)),
+	})
+}
@@ -0,0 +1,223 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+type CommandType string
+
+const (
+	CommandBuildSettlement           CommandType = "BuildSettlement"
+	CommandBuildRoad                 CommandType = "BuildRoad"
+	CommandBuildCity                 CommandType = "BuildCity"
+	CommandBuildShip                 CommandType = "BuildShip"
+	CommandMoveShip                  CommandType = "MoveShip"
+	CommandRollDice                  CommandType = "RollDice"
+	CommandEndRound                  CommandType = "EndRound"
+	CommandDiscardPlayerCards        CommandType = "DiscardPlayerCards"
+	CommandPickGoldResources         CommandType = "PickGoldResources"
+	CommandMoveRobber                CommandType = "MoveRobber"
+	CommandRobPlayer                 CommandType = "RobPlayer"
+	CommandBuyDevelopmentCard        CommandType = "BuyDevelopmentCard"
+	CommandUseDevelopmentCard        CommandType = "UseDevelopmentCard"
+	CommandUseKnight                 CommandType = "UseKnight"
+	CommandUseMonopoly               CommandType = "UseMonopoly"
+	CommandPickMonopolyResource      CommandType = "PickMonopolyResource"
+	CommandUseRoadBuilding           CommandType = "UseRoadBuilding"
+	CommandPickRoadBuildingSpot      CommandType = "PickRoadBuildingSpot"
+	CommandUseYearOfPlenty           CommandType = "UseYearOfPlenty"
+	CommandPickYearOfPlentyResources CommandType = "PickYearOfPlentyResources"
+	CommandMakeBankTrade             CommandType = "MakeBankTrade"
+	CommandMakeGeneralPortTrade      CommandType = "MakeGeneralPortTrade"
+	CommandMakeResourcePortTrade     CommandType = "MakeResourcePortTrade"
+	CommandMakeTradeOffer            CommandType = "MakeTradeOffer"
+	CommandMakeCounterTradeOffer     CommandType = "MakeCounterTradeOffer"
+	CommandAcceptTradeOffer          CommandType = "AcceptTradeOffer"
+	CommandRejectTradeOffer          CommandType = "RejectTradeOffer"
+	CommandCancelTradeOffer          CommandType = "CancelTradeOffer"
+	CommandFinalizeTrade             CommandType = "FinalizeTrade"
+	CommandForceTrade                CommandType = "ForceTrade"
+	CommandMoveRobberToDesert        CommandType = "MoveRobberToDesert"
+	CommandEndGame                   CommandType = "EndGame"
+)
+
+// Command is a successful mutating call made on GameState. Only the fields relevant to its type are set
+type Command struct {
+	Type     CommandType `json:"type"`
+	Round    int         `json:"round"`
+	PlayerID string      `json:"playerId,omitempty"`
+	VertexID int         `json:"vertexId,omitempty"`
+	EdgeID   int         `json:"edgeId,omitempty"`
+	// Only set for CommandMoveShip, where EdgeID is the edge the ship leaves
+	SecondEdgeID    int             `json:"secondEdgeId,omitempty"`
+	TileID          int             `json:"tileId,omitempty"`
+	TradeID         int             `json:"tradeId,omitempty"`
+	TargetPlayerID  string          `json:"targetPlayerId,omitempty"`
+	DevelopmentCard string          `json:"developmentCard,omitempty"`
+	Resource        coreT.Resource  `json:"resource,omitempty"`
+	SecondResource  coreT.Resource  `json:"secondResource,omitempty"`
+	Resources       coreT.Resources `json:"resources,omitzero"`
+	Given           coreT.Resources `json:"given,omitzero"`
+	Requested       coreT.Resources `json:"requested,omitzero"`
+	BlockedPlayers  []string        `json:"blockedPlayers,omitempty"`
+}
+
+type ActionLog struct {
+	Players  []coreT.Player `json:"players"`
+	Commands []Command      `json:"commands"`
+}
+
+// record must be deferred at the start of every mutating method as
+// `defer state.record(Command{...})(&err)`.
+// Commands issued from within another command (e.g. UseKnight from UseDevelopmentCard) are not recorded.
+// Events emitted during the command are delivered once the outermost command is done.
+// In strict mode, the state is validated once the outermost command is done.
+func (state *GameState) record(command Command) func(*error) {
+	command.Round = state.round.GetRoundNumber()
+	command.BlockedPlayers = append([]string(nil), command.BlockedPlayers...)
+	state.commandDepth++
+	return func(err *error) {
+		state.commandDepth--
+		if state.commandDepth > 0 {
+			return
+		}
+		if *err == nil {
+			state.actionLog = append(state.actionLog, command)
+		}
+		state.flushEvents()
+		if !state.strict {
+			return
+		}
+		if violations := state.Validate(); violations != nil {
+			if *err != nil {
+				*err = errors.Join(*err, violations)
+			} else {
+				*err = fmt.Errorf("State invalid after %s: %w", command.Type, violations)
+			}
+		}
+	}
+}
+
+func (state *GameState) ActionLog() ActionLog {
+	return ActionLog{
+		Players:  append([]coreT.Player{}, state.players...),
+		Commands: append([]Command{}, state.actionLog...),
+	}
+}
+
+// Seed returns the seed the game was created with, or 0 if the game wasn't created with NewWithSeed
+func (state *GameState) Seed() int64 {
+	if state.randSource == nil {
+		return 0
+	}
+	seed, _ := state.randSource.Position()
+	return seed
+}
+
+// ApplyCommand calls the mutating method matching command.Type with its arguments
+func (state *GameState) ApplyCommand(command Command) error {
+	var err error
+	switch command.Type {
+	case CommandBuildSettlement:
+		err = state.BuildSettlement(command.PlayerID, command.VertexID)
+	case CommandBuildRoad:
+		err = state.BuildRoad(command.PlayerID, command.EdgeID)
+	case CommandBuildCity:
+		err = state.BuildCity(command.PlayerID, command.VertexID)
+	case CommandBuildShip:
+		err = state.BuildShip(command.PlayerID, command.EdgeID)
+	case CommandMoveShip:
+		err = state.MoveShip(command.PlayerID, command.EdgeID, command.SecondEdgeID)
+	case CommandRollDice:
+		err = state.RollDice(command.PlayerID)
+	case CommandEndRound:
+		err = state.EndRound(command.PlayerID)
+	case CommandDiscardPlayerCards:
+		err = state.DiscardPlayerCards(command.PlayerID, command.Resources)
+	case CommandPickGoldResources:
+		err = state.PickGoldResources(command.PlayerID, command.Resources)
+	case CommandMoveRobber:
+		err = state.MoveRobber(command.PlayerID, command.TileID)
+	case CommandRobPlayer:
+		err = state.RobPlayer(command.PlayerID, command.TargetPlayerID)
+	case CommandBuyDevelopmentCard:
+		err = state.BuyDevelopmentCard(command.PlayerID)
+	case CommandUseDevelopmentCard:
+		err = state.UseDevelopmentCard(command.PlayerID, command.DevelopmentCard)
+	case CommandUseKnight:
+		err = state.UseKnight(command.PlayerID)
+	case CommandUseMonopoly:
+		err = state.UseMonopoly(command.PlayerID)
+	case CommandPickMonopolyResource:
+		err = state.PickMonopolyResource(command.PlayerID, command.Resource)
+	case CommandUseRoadBuilding:
+		err = state.UseRoadBuilding(command.PlayerID)
+	case CommandPickRoadBuildingSpot:
+		err = state.PickRoadBuildingSpot(command.PlayerID, command.EdgeID)
+	case CommandUseYearOfPlenty:
+		err = state.UseYearOfPlenty(command.PlayerID)
+	case CommandPickYearOfPlentyResources:
+		err = state.PickYearOfPlentyResources(command.PlayerID, command.Resource, command.SecondResource)
+	case CommandMakeBankTrade:
+		err = state.MakeBankTrade(command.PlayerID, command.Given, command.Requested)
+	case CommandMakeGeneralPortTrade:
+		err = state.MakeGeneralPortTrade(command.PlayerID, command.Given, command.Requested)
+	case CommandMakeResourcePortTrade:
+		err = state.MakeResourcePortTrade(command.PlayerID, command.Given, command.Requested)
+	case CommandMakeTradeOffer:
+		_, err = state.MakeTradeOffer(command.PlayerID, command.Given, command.Requested, command.BlockedPlayers)
+	case CommandMakeCounterTradeOffer:
+		_, err = state.MakeCounterTradeOffer(command.PlayerID, command.TradeID, command.Given, command.Requested)
+	case CommandAcceptTradeOffer:
+		err = state.AcceptTradeOffer(command.PlayerID, command.TradeID)
+	case CommandRejectTradeOffer:
+		err = state.RejectTradeOffer(command.PlayerID, command.TradeID)
+	case CommandCancelTradeOffer:
+		err = state.CancelTradeOffer(command.PlayerID, command.TradeID)
+	case CommandFinalizeTrade:
+		err = state.FinalizeTrade(command.PlayerID, command.TargetPlayerID, command.TradeID)
+	case CommandForceTrade:
+		err = state.ForceTrade(command.PlayerID, command.TargetPlayerID, command.Given)
+	case CommandMoveRobberToDesert:
+		err = state.MoveRobberToDesert(command.PlayerID)
+	case CommandEndGame:
+		state.EndGame()
+	default:
+		err = fmt.Errorf("Unknown command: %s", command.Type)
+	}
+	return err
+}
+
+// Replay rebuilds a game from scratch by applying every command of the log in order
+func Replay(seed int64, mapName string, params Params, log ActionLog) (*GameState, error) {
+	players := make([]*coreT.Player, len(log.Players))
+	for i := range log.Players {
+		players[i] = &coreT.Player{
+			ID:    log.Players[i].ID,
+			Color: log.Players[i].Color,
+		}
+	}
+
+	state := &GameState{}
+	err := state.NewWithSeed(players, mapName, seed, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, command := range log.Commands {
+		if command.Round != state.round.GetRoundNumber() {
+			err := fmt.Errorf("Cannot replay command #%d (%s): recorded at round %d, but game is at round %d", i, command.Type, command.Round, state.round.GetRoundNumber())
+			return nil, err
+		}
+		err := state.ApplyCommand(command)
+		if err != nil {
+			err := fmt.Errorf("Cannot replay command #%d (%s): %s", i, command.Type, err.Error())
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
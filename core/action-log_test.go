@@ -0,0 +1,199 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+)
+
+// replayComparableJSON ignores which one of the equally long roads is picked as longest road,
+// since it depends on map iteration order
+func replayComparableJSON(t *testing.T, game *GameState) []byte {
+	snapshot, err := game.Snapshot()
+	if err != nil {
+		t.Fatalf("expected to snapshot game just fine, but actually got error %s", err.Error())
+	}
+	for playerID, playerSnapshot := range snapshot.PlayersStates {
+		playerSnapshot.LongestRoadSegments = make([]int, len(playerSnapshot.LongestRoadSegments))
+		snapshot.PlayersStates[playerID] = playerSnapshot
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatalf("expected to marshal snapshot just fine, but actually got error %s", err.Error())
+	}
+	return data
+}
+
+func TestActionLog(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+
+	t.Run("failed calls are not recorded", func(t *testing.T) {
+		err := game.RollDice("1")
+		if err == nil {
+			t.Errorf("expected to have error since dice cannot be rolled during setup, but actually no error was found")
+		}
+		if len(game.ActionLog().Commands) != 0 {
+			t.Errorf("expected action log to be empty, but it has %d commands", len(game.ActionLog().Commands))
+		}
+	})
+
+	playSetup(t, game)
+
+	t.Run("successful calls are recorded in order", func(t *testing.T) {
+		commands := game.ActionLog().Commands
+		if len(commands) != 4*len(game.players) {
+			t.Fatalf("expected action log to have %d commands, but it has %d", 4*len(game.players), len(commands))
+		}
+		if commands[0].Type != CommandBuildSettlement || commands[1].Type != CommandBuildRoad {
+			t.Errorf("expected first commands to be %s and %s, but they're actually %s and %s", CommandBuildSettlement, CommandBuildRoad, commands[0].Type, commands[1].Type)
+		}
+		if commands[0].PlayerID != game.players[0].ID {
+			t.Errorf("expected first command to be issued by player#%s, but it was issued by player#%s", game.players[0].ID, commands[0].PlayerID)
+		}
+	})
+
+	t.Run("unset resources are left out", func(t *testing.T) {
+		data, err := json.Marshal(game.ActionLog().Commands[0])
+		if err != nil {
+			t.Fatalf("expected to marshal command just fine, but actually got error %s", err.Error())
+		}
+		if strings.Contains(string(data), "resources") || strings.Contains(string(data), "given") {
+			t.Errorf("expected %s command to have no resources, but actually got %s", CommandBuildSettlement, data)
+		}
+	})
+}
+
+func TestReplay(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playSetup(t, game)
+	for i := 0; i < 20; i++ {
+		playerID := game.CurrentRoundPlayer().ID
+		if err := game.RollDice(playerID); err != nil {
+			t.Fatalf("expected player#%s to roll dice just fine, but actually got error %s", playerID, err.Error())
+		}
+		if game.RoundType() != round.Regular {
+			// Non trivial rounds (robber, discard) are out of scope of this test
+			break
+		}
+		game.EndRound(playerID)
+	}
+
+	data, err := json.Marshal(game.ActionLog())
+	if err != nil {
+		t.Fatalf("expected to marshal action log just fine, but actually got error %s", err.Error())
+	}
+	var log ActionLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("expected to unmarshal action log just fine, but actually got error %s", err.Error())
+	}
+
+	replayed, err := Replay(game.Seed(), "base4", seededTestParams, log)
+	if err != nil {
+		t.Fatalf("expected to replay game just fine, but actually got error %s", err.Error())
+	}
+
+	if string(replayComparableJSON(t, game)) != string(replayComparableJSON(t, replayed)) {
+		t.Errorf("expected replayed game to be identical to the original one")
+	}
+
+	t.Run("log with unknown resource", func(t *testing.T) {
+		data := []byte(`{"commands":[{"playerId":"1","resources":{"Wool":1}}]}`)
+		var log ActionLog
+		if err := json.Unmarshal(data, &log); err == nil {
+			t.Errorf("expected to have error since Wool isn't a resource, but actually no error was found")
+		}
+	})
+
+	t.Run("log out of sync", func(t *testing.T) {
+		log.Commands[len(log.Commands)-1].Round++
+		_, err := Replay(game.Seed(), "base4", seededTestParams, log)
+		if err == nil {
+			t.Errorf("expected to have error since last command has wrong round, but actually no error was found")
+		}
+	})
+}
+
+// pickTestAction builds and buys whatever it can, never trades and otherwise takes the first legal action,
+// so hands get emptied every now and then
+func pickTestAction(game *GameState, actions []LegalAction) Command {
+	for _, action := range actions {
+		switch action.Type {
+		case CommandBuildSettlement, CommandBuildCity, CommandBuildRoad, CommandBuyDevelopmentCard:
+			return action.Command
+		}
+	}
+	for _, action := range actions {
+		if action.Type == CommandEndRound {
+			return action.Command
+		}
+	}
+	return concreteCommand(game, actions[0])
+}
+
+// robsEmptyHanded tells whether moving the robber with command leaves only players with no cards to rob
+func robsEmptyHanded(game *GameState, command Command) bool {
+	clone := game.Clone()
+	if clone.ApplyCommand(command) != nil || clone.RoundType() != round.PickRobbed {
+		return false
+	}
+	robbablePlayers, _ := clone.RobbablePlayers(command.PlayerID)
+	for _, robbedID := range robbablePlayers {
+		if clone.NumberOfCardsInHandByPlayer(robbedID) > 0 {
+			return false
+		}
+	}
+	return len(robbablePlayers) > 0
+}
+
+func TestReplayEmptyHandedRob(t *testing.T) {
+	for seed := int64(1); seed <= 50; seed++ {
+		game := createSeededTestGame(t, seed)
+		robbed := false
+		for step := 0; step < 2000 && !robbed && game.RoundType() != round.GameOver; step++ {
+			var command Command
+			for _, player := range game.Players() {
+				actions := game.LegalActions(player.ID)
+				if len(actions) == 0 {
+					continue
+				}
+				command = pickTestAction(game, actions)
+				for _, action := range actions {
+					if action.Type == CommandMoveRobber && robsEmptyHanded(game, action.Command) {
+						command = action.Command
+						robbed = true
+						break
+					}
+				}
+				break
+			}
+			if err := game.ApplyCommand(command); err != nil {
+				t.Fatalf("expected to apply %s just fine, but actually got error %s", command.Type, err.Error())
+			}
+		}
+		if !robbed {
+			continue
+		}
+
+		robbablePlayers, _ := game.RobbablePlayers(game.CurrentRoundPlayer().ID)
+		err := game.RobPlayer(game.CurrentRoundPlayer().ID, robbablePlayers[0])
+		if err != nil {
+			t.Fatalf("expected to rob player#%s with no cards just fine, but actually got error %s", robbablePlayers[0], err.Error())
+		}
+		if game.RoundType() == round.PickRobbed {
+			t.Errorf("expected robbing to end %s", round.RoundTypeTranslation[round.PickRobbed])
+		}
+		game.EndRound(game.CurrentRoundPlayer().ID)
+
+		replayed, err := Replay(game.Seed(), "base4", seededTestParams, game.ActionLog())
+		if err != nil {
+			t.Fatalf("expected to replay game just fine, but actually got error %s", err.Error())
+		}
+		if string(replayComparableJSON(t, game)) != string(replayComparableJSON(t, replayed)) {
+			t.Errorf("expected replayed game to be identical to the original one")
+		}
+		return
+	}
+	t.Fatalf("expected some game to have the robber next to a player with no cards")
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+var balancedBoard = withParams(func(params *Params) { params.BalancedBoard = 1 })
+
+func TestBalancedBoard(t *testing.T) {
+	t.Run("balanced board - follows constraints", func(t *testing.T) {
+		for seed := int64(1); seed <= 20; seed++ {
+			game := createSeededTestGame(t, seed, balancedBoard)
+			report := game.BoardBalance()
+			if !coreMaps.DefaultBalanceConstraints.SatisfiedBy(report) {
+				t.Errorf("expected board of seed %d to be balanced, actually got %+v", seed, report)
+			}
+		}
+	})
+
+	t.Run("balanced board - deterministic", func(t *testing.T) {
+		game1 := createSeededTestGame(t, 42, balancedBoard)
+		game2 := createSeededTestGame(t, 42, balancedBoard)
+		if !reflect.DeepEqual(game1.GetBoard(), game2.GetBoard()) {
+			t.Errorf("expected same seed to generate the same board")
+		}
+		if !reflect.DeepEqual(game1.Ports(), game2.Ports()) {
+			t.Errorf("expected same seed to generate the same ports")
+		}
+	})
+}
+
+func TestBoardBalanceReport(t *testing.T) {
+	coreMaps.LoadMap("base4")
+	definitions, _ := coreMaps.GetMapDefinitions("base4")
+	tiles := make([]coreT.MapBlock, len(definitions.Tiles))
+	for i, tileID := range definitions.Tiles {
+		tiles[i] = coreT.MapBlock{ID: tileID, Resource: "Grain", Token: 6}
+	}
+	tiles[0].Resource = "Desert"
+	tiles[0].Token = 0
+
+	report := coreMaps.Balance(definitions, tiles)
+	if report.AdjacentRedNumbers == 0 || report.AdjacentRedNumbers != report.AdjacentSameNumbers {
+		t.Errorf("expected every pair of neighbouring 6s to be counted, actually got %d red and %d same", report.AdjacentRedNumbers, report.AdjacentSameNumbers)
+	}
+	if report.LargestClusters["Grain"] != 18 {
+		t.Errorf("expected a single cluster of 18 grain tiles, actually got %d", report.LargestClusters["Grain"])
+	}
+	if report.PipsByResource["Grain"] != 90 || report.PipVariance != 0 {
+		t.Errorf("expected 90 grain pips and no variance, actually got %d pips and %f variance", report.PipsByResource["Grain"], report.PipVariance)
+	}
+	if report.Score != 0 {
+		t.Errorf("expected score to bottom out at 0, actually got %f", report.Score)
+	}
+}
@@ -0,0 +1,57 @@
+package core
+
+import coreT "github.com/victoroliveirab/settlers/core/types"
+
+// BankResources returns how many cards of each resource are left in the bank
+func (state *GameState) BankResources() coreT.Resources {
+	return state.bank.GetResources()
+}
+
+func (state *GameState) bankHasTwoResources() bool {
+	return state.bank.GetResources().Total() >= 2
+}
+
+// payFromBank hands out the resources owed to each player (resource -> player -> quantity) following
+// the shortage rule: if the bank can't pay everyone a resource, nobody receives it,
+// unless a single player is owed it, who then gets whatever is left
+func (state *GameState) payFromBank(owed map[coreT.Resource]map[string]int) {
+	produced := make(map[string]coreT.Resources)
+	for _, resource := range ResourcesOrder {
+		owedByPlayer := owed[resource]
+		total := 0
+		for _, quantity := range owedByPlayer {
+			total += quantity
+		}
+		if total == 0 {
+			continue
+		}
+
+		available := state.bank.Available(resource)
+		if total > available && len(owedByPlayer) > 1 {
+			continue
+		}
+		for _, player := range state.players {
+			quantity := min(owedByPlayer[player.ID], available)
+			if quantity == 0 {
+				continue
+			}
+			paid := coreT.ResourcesOf(resource, quantity)
+			state.bank.Take(paid)
+			state.playersStates[player.ID].AddResources(paid)
+			state.bookKeeping.AddResourcesDrawn(player.ID, paid)
+			produced[player.ID] = produced[player.ID].Add(paid)
+		}
+	}
+	for _, player := range state.players {
+		if resources, exists := produced[player.ID]; exists {
+			state.emit(ResourcesProduced{PlayerID: player.ID, Resources: resources})
+		}
+	}
+}
+
+// payToBank takes cost out of the player's hand and puts it back in the bank
+func (state *GameState) payToBank(playerID string, cost coreT.Resources) {
+	state.playersStates[playerID].RemoveResources(cost)
+	state.bank.Return(cost)
+	state.bookKeeping.AddResourcesUsed(playerID, cost)
+}
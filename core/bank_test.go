@@ -0,0 +1,178 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func TestBankShortage(t *testing.T) {
+	createGame := func() *GameState {
+		return CreateTestGame(
+			MockWithRoundType(round.Regular),
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
+				"1": {},
+				"2": {},
+				"4": {
+					Ore: 17,
+				},
+			}),
+		)
+	}
+
+	t.Run("bank can't pay every player owed - nobody receives", func(t *testing.T) {
+		game := createGame()
+		game.payFromBank(map[coreT.Resource]map[string]int{
+			coreT.Ore:   {"1": 2, "2": 1},
+			coreT.Grain: {"1": 1, "2": 1},
+		})
+
+		if game.ResourceHandByPlayer("1").Ore != 0 || game.ResourceHandByPlayer("2").Ore != 0 {
+			t.Errorf("expected no player to receive Ore, actually got player#1 with %d and player#2 with %d", game.ResourceHandByPlayer("1").Ore, game.ResourceHandByPlayer("2").Ore)
+		}
+		if game.BankResources().Ore != 2 {
+			t.Errorf("expected bank to still have 2 Ore, actually got %d", game.BankResources().Ore)
+		}
+		if game.ResourceHandByPlayer("1").Grain != 1 || game.ResourceHandByPlayer("2").Grain != 1 {
+			t.Errorf("expected both players to receive Grain, actually got player#1 with %d and player#2 with %d", game.ResourceHandByPlayer("1").Grain, game.ResourceHandByPlayer("2").Grain)
+		}
+	})
+
+	t.Run("bank can't pay a single player owed - player receives what is left", func(t *testing.T) {
+		game := createGame()
+		game.payFromBank(map[coreT.Resource]map[string]int{
+			coreT.Ore: {"1": 3},
+		})
+
+		if game.ResourceHandByPlayer("1").Ore != 2 {
+			t.Errorf("expected player#1 to receive 2 Ore, actually got %d", game.ResourceHandByPlayer("1").Ore)
+		}
+		if game.BankResources().Ore != 0 {
+			t.Errorf("expected bank to have 0 Ore, actually got %d", game.BankResources().Ore)
+		}
+	})
+}
+
+func TestBankTradeWithEmptyBank(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
+			"1": {
+				Lumber: 4,
+			},
+			"2": {
+				Ore: 19,
+			},
+		}),
+	)
+
+	t.Run("trade with bank - bank doesn't have requested resource", func(t *testing.T) {
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1})
+		if err == nil {
+			t.Errorf("expected to not be able to trade with bank, but actually traded just fine")
+		}
+		if game.ResourceHandByPlayer("1").Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber, actually got %d", game.ResourceHandByPlayer("1").Lumber)
+		}
+	})
+
+	t.Run("trade with bank - given resources go back to the bank", func(t *testing.T) {
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Grain: 1})
+		if err != nil {
+			t.Errorf("expected to trade with bank just fine, but actually got error %s", err.Error())
+		}
+		if game.BankResources().Lumber != 19 {
+			t.Errorf("expected bank to have 19 Lumber, actually got %d", game.BankResources().Lumber)
+		}
+		if game.BankResources().Grain != 18 {
+			t.Errorf("expected bank to have 18 Grain, actually got %d", game.BankResources().Grain)
+		}
+	})
+}
+
+func TestDiscardReturnsResourcesToBank(t *testing.T) {
+	rand := StubRand(7)
+	game := CreateTestGame(
+		MockWithRoundType(round.BetweenTurns),
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
+			"1": {
+				Lumber: 8,
+			},
+		}),
+		MockWithRand(rand),
+	)
+
+	t.Run("discard - discarded resources go back to the bank", func(t *testing.T) {
+		game.RollDice("1")
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 4,
+		})
+		if err != nil {
+			t.Errorf("expected to discard resources correctly, but actually got error %s", err.Error())
+		}
+		if game.BankResources().Lumber != 15 {
+			t.Errorf("expected bank to have 15 Lumber, actually got %d", game.BankResources().Lumber)
+		}
+	})
+}
+
+func TestYearOfPlentyWithBankShortage(t *testing.T) {
+	createGame := func(resourcesByPlayer map[string]coreT.Resources) *GameState {
+		return CreateTestGame(
+			MockWithRoundType(round.Regular),
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {1},
+			}),
+			MockWithRoundNumber(5),
+			MockWithDevelopmentsByPlayer(map[string]map[string][]*coreT.DevelopmentCard{
+				"1": {
+					"Year of Plenty": {&coreT.DevelopmentCard{
+						Name:        "Year of Plenty",
+						RoundBought: 1,
+					}},
+				},
+			}),
+			MockWithResourcesByPlayer(resourcesByPlayer),
+		)
+	}
+
+	t.Run("bank doesn't have the picked resource", func(t *testing.T) {
+		game := createGame(map[string]coreT.Resources{
+			"1": {},
+			"2": {
+				Ore: 19,
+			},
+		})
+		err := game.UseYearOfPlenty("1")
+		if err != nil {
+			t.Fatalf("expected to use year of plenty card just fine, but actually got error %s", err.Error())
+		}
+		err = game.PickYearOfPlentyResources("1", "Grain", "Ore")
+		if err == nil {
+			t.Errorf("expected to not be able to pick Ore from an empty bank, but actually picked just fine")
+		}
+		err = game.PickYearOfPlentyResources("1", "Grain", "Grain")
+		if err != nil {
+			t.Errorf("expected to pick two Grain just fine, but actually got error %s", err.Error())
+		}
+	})
+
+	t.Run("bank has less than two resources", func(t *testing.T) {
+		game := createGame(map[string]coreT.Resources{
+			"2": {
+				Lumber: 19,
+				Brick:  19,
+				Sheep:  19,
+			},
+			"3": {
+				Grain: 19,
+				Ore:   18,
+			},
+		})
+		err := game.UseYearOfPlenty("1")
+		if err == nil {
+			t.Errorf("expected to not be able to use year of plenty with a single resource in the bank, but actually used just fine")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+func TestBoardCodeRoundTrip(t *testing.T) {
+	coreMaps.LoadMap("base4")
+	coreMaps.LoadMap("islands4")
+	for _, mapName := range []string{"base4", "islands4"} {
+		definitions, _ := coreMaps.GetMapDefinitions(mapName)
+		for seed := int64(1); seed <= 20; seed++ {
+			generated := coreMaps.GenerateMap(definitions, utils.RandNew(seed))
+			code, err := coreMaps.Encode(definitions, generated)
+			if err != nil {
+				t.Fatalf("expected to encode %s board just fine, but actually got error %s", mapName, err.Error())
+			}
+			if strings.ContainsAny(code, "+/=") {
+				t.Errorf("expected code %s to be URL-safe", code)
+			}
+			decoded, err := coreMaps.Decode(definitions, code)
+			if err != nil {
+				t.Fatalf("expected to decode %s just fine, but actually got error %s", code, err.Error())
+			}
+			if !reflect.DeepEqual(decoded.Tiles, generated.Tiles) {
+				t.Errorf("expected decoded %s tiles to be the generated ones", mapName)
+			}
+			if !reflect.DeepEqual(decoded.Ports, generated.Ports) {
+				t.Errorf("expected decoded %s ports to be the generated ones", mapName)
+			}
+		}
+	}
+}
+
+func TestPlayBoardAgain(t *testing.T) {
+	game := createSeededTestGame(t, 7, withLayout(coreMaps.LayoutRandom))
+	code := game.BoardCode()
+	if code == "" {
+		t.Fatalf("expected generated board to have a code")
+	}
+
+	again := createSeededTestGame(t, 99, withParams(func(params *Params) { params.BoardCode = code }))
+	if !reflect.DeepEqual(again.GetBoard(), game.GetBoard()) {
+		t.Errorf("expected board code to recreate the same tiles")
+	}
+	if !reflect.DeepEqual(again.Ports(), game.Ports()) {
+		t.Errorf("expected board code to recreate the same ports")
+	}
+	if again.BoardCode() != code {
+		t.Errorf("expected game to keep board code %s, actually got %s", code, again.BoardCode())
+	}
+}
+
+func TestInvalidBoardCode(t *testing.T) {
+	coreMaps.LoadMap("base4")
+	coreMaps.LoadMap("base6")
+	base4, _ := coreMaps.GetMapDefinitions("base4")
+	base6, _ := coreMaps.GetMapDefinitions("base6")
+	code, _ := coreMaps.Encode(base4, coreMaps.GenerateMap(base4, utils.RandNew(1)))
+
+	t.Run("not base64", func(t *testing.T) {
+		if _, err := coreMaps.Decode(base4, "not a code!"); err == nil {
+			t.Errorf("expected to have error since code isn't base64, but actually no error was found")
+		}
+	})
+
+	t.Run("code of another map", func(t *testing.T) {
+		if _, err := coreMaps.Decode(base6, code); err == nil {
+			t.Errorf("expected to have error since code is from base4, but actually no error was found")
+		}
+	})
+
+	t.Run("tampered tiles", func(t *testing.T) {
+		generated := coreMaps.GenerateMap(base4, utils.RandNew(1))
+		for i := range generated.Tiles {
+			if generated.Tiles[i].Resource != "Desert" {
+				generated.Tiles[i].Token = 6
+			}
+		}
+		tampered, _ := coreMaps.Encode(base4, generated)
+		if _, err := coreMaps.Decode(base4, tampered); err == nil {
+			t.Errorf("expected to have error since tokens don't match the map ones, but actually no error was found")
+		}
+	})
+
+	t.Run("token on the desert", func(t *testing.T) {
+		generated := coreMaps.GenerateMap(base4, utils.RandNew(1))
+		desert := slices.IndexFunc(generated.Tiles, func(tile coreT.MapBlock) bool { return tile.Resource == "Desert" })
+		other := (desert + 1) % len(generated.Tiles)
+		generated.Tiles[desert].Token, generated.Tiles[other].Token = generated.Tiles[other].Token, 0
+		tampered, _ := coreMaps.Encode(base4, generated)
+		if _, err := coreMaps.Decode(base4, tampered); err == nil {
+			t.Errorf("expected to have error since the desert has a token and tile#%d has none, but actually no error was found", generated.Tiles[other].ID)
+		}
+	})
+
+	t.Run("no robber", func(t *testing.T) {
+		generated := coreMaps.GenerateMap(base4, utils.RandNew(1))
+		for i := range generated.Tiles {
+			generated.Tiles[i].Blocked = false
+		}
+		tampered, _ := coreMaps.Encode(base4, generated)
+		if _, err := coreMaps.Decode(base4, tampered); err == nil {
+			t.Errorf("expected to have error since the robber isn't on the desert, but actually no error was found")
+		}
+	})
+}
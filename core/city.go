@@ -3,31 +3,36 @@ package core
 import (
 	"fmt"
 
-	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
-func (state *GameState) BuildCity(playerID string, vertexID int) error {
+func (state *GameState) BuildCity(playerID string, vertexID int) (err error) {
+	defer state.record(Command{Type: CommandBuildCity, PlayerID: playerID, VertexID: vertexID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot build city during other player's turn")
 		return err
 	}
 
-	if state.round.GetRoundType() != round.Regular {
+	if !state.isBuildingRound() {
 		err := fmt.Errorf("Cannot build city during %s", state.round.GetCurrentRoundTypeDescription())
 		return err
 	}
 
 	vertice, exists := state.board.GetSettlements()[vertexID]
 	if exists && vertice.Owner != playerID {
-		owner := state.findPlayer(vertice.Owner)
-		err := fmt.Errorf("Player %s already has settlement at vertex #%d", owner.ID, vertexID)
+		err := fmt.Errorf("Player %s already has settlement at vertex #%d", vertice.Owner, vertexID)
 		return err
 	}
 
 	vertice, exists = state.board.GetCities()[vertexID]
 	if exists {
-		owner := state.findPlayer(vertice.Owner)
-		err := fmt.Errorf("Player %s already has city at vertex #%d", owner.ID, vertexID)
+		err := fmt.Errorf("Player %s already has city at vertex #%d", vertice.Owner, vertexID)
+		return err
+	}
+
+	if _, hasSettlement := state.board.GetSettlements()[vertexID]; !hasSettlement {
+		err := fmt.Errorf("Cannot build city at vertex #%d without a settlement of your own", vertexID)
 		return err
 	}
 
@@ -46,10 +51,8 @@ func (state *GameState) BuildCity(playerID string, vertexID int) error {
 
 	state.board.AddCity(playerID, vertexID)
 	playerState.AddCity(vertexID)
-	playerState.RemoveResource("Grain", 2)
-	playerState.RemoveResource("Ore", 3)
-	state.bookKeeping.AddResourcesUsed(playerID, "Grain", 2)
-	state.bookKeeping.AddResourcesUsed(playerID, "Ore", 3)
+	state.payToBank(playerID, coreT.CityCost)
+	state.emit(CityBuilt{PlayerID: playerID, VertexID: vertexID})
 	state.updatePoints()
 
 	return nil
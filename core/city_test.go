@@ -5,6 +5,7 @@ import (
 
 	"github.com/victoroliveirab/settlers/core/packages/board"
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestBuildCitySuccess(t *testing.T) {
@@ -13,13 +14,13 @@ func TestBuildCitySuccess(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  3,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
 			},
 		}),
 	)
@@ -57,20 +58,20 @@ func TestBuildCitySuccess(t *testing.T) {
 		}
 
 		player1ResourcesAfterBuild := game.ResourceHandByPlayer("1")
-		if player1ResourcesAfterBuild["Lumber"] != 0 {
-			t.Errorf("expected to have 0 Lumber after build city, but found %d", player1ResourcesAfterBuild["Lumber"])
+		if player1ResourcesAfterBuild.Lumber != 0 {
+			t.Errorf("expected to have 0 Lumber after build city, but found %d", player1ResourcesAfterBuild.Lumber)
 		}
-		if player1ResourcesAfterBuild["Brick"] != 1 {
-			t.Errorf("expected to have 2 Brick after build city, but found %d", player1ResourcesAfterBuild["Brick"])
+		if player1ResourcesAfterBuild.Brick != 1 {
+			t.Errorf("expected to have 2 Brick after build city, but found %d", player1ResourcesAfterBuild.Brick)
 		}
-		if player1ResourcesAfterBuild["Sheep"] != 2 {
-			t.Errorf("expected to have 1 Sheep after build city, but found %d", player1ResourcesAfterBuild["Sheep"])
+		if player1ResourcesAfterBuild.Sheep != 2 {
+			t.Errorf("expected to have 1 Sheep after build city, but found %d", player1ResourcesAfterBuild.Sheep)
 		}
-		if player1ResourcesAfterBuild["Grain"] != 1 {
-			t.Errorf("expected to have 1 Grain after build city, but found %d", player1ResourcesAfterBuild["Grain"])
+		if player1ResourcesAfterBuild.Grain != 1 {
+			t.Errorf("expected to have 1 Grain after build city, but found %d", player1ResourcesAfterBuild.Grain)
 		}
-		if player1ResourcesAfterBuild["Ore"] != 1 {
-			t.Errorf("expected to have 1 Ore after build city, but found %d", player1ResourcesAfterBuild["Ore"])
+		if player1ResourcesAfterBuild.Ore != 1 {
+			t.Errorf("expected to have 1 Ore after build city, but found %d", player1ResourcesAfterBuild.Ore)
 		}
 	})
 }
@@ -81,13 +82,13 @@ func TestBuildCityErrorAlreadyExistsByPlayer(t *testing.T) {
 		MockWithCitiesByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  3,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
 			},
 		}),
 	)
@@ -106,13 +107,13 @@ func TestBuildCityErrorSettlementAlreadyExistsOtherPlayer(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  3,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
 			},
 		}),
 	)
@@ -125,19 +126,47 @@ func TestBuildCityErrorSettlementAlreadyExistsOtherPlayer(t *testing.T) {
 	})
 }
 
+func TestBuildCityErrorNoSettlement(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+		MockWithSettlementsByPlayer(map[string][]int{
+			"1": {42},
+		}),
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
+			"1": {
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
+			},
+		}),
+	)
+
+	t.Run("city build error - player has no settlement in vertex", func(t *testing.T) {
+		err := game.BuildCity("1", 1)
+		if err == nil {
+			t.Errorf("expected to not be able to build city in empty vertex#1, but it built just fine")
+		}
+		if len(game.GetAllCities()) != 0 {
+			t.Errorf("expected no city to be built, but found %d", len(game.GetAllCities()))
+		}
+	})
+}
+
 func TestBuildCityErrorCityAlreadyExistsOtherPlayer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
 		MockWithCitiesByPlayer(map[string][]int{
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  3,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
 			},
 		}),
 	)
@@ -157,13 +186,13 @@ func TestBuildCityErrorNotPlayerRound(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  3,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
 			},
 		}),
 	)
@@ -182,13 +211,13 @@ func TestBuildCityErrorNotEnoughResources(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    4,
 			},
 		}),
 	)
@@ -207,13 +236,13 @@ func TestBuildCityErrorNotAppropriateRound(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  3,
-				"Ore":    4,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  3,
+				Ore:    4,
 			},
 		}),
 	)
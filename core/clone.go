@@ -0,0 +1,65 @@
+package core
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+
+	"github.com/victoroliveirab/settlers/core/packages/player"
+	"github.com/victoroliveirab/settlers/core/packages/summary"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// Clone returns a copy of the game that shares no state with the original one.
+// If the game was created with NewWithSeed, the clone continues the same random sequence as the original,
+// otherwise it gets a brand new random generator
+func (state *GameState) Clone() *GameState {
+	var source *utils.RandSource
+	if state.randSource != nil {
+		source = state.randSource.Clone()
+	} else {
+		source = utils.NewRandSource(0)
+	}
+	return state.clone(source)
+}
+
+// CloneWithSeed is like Clone, but the copy draws its random numbers (dice, robbed cards) from a new sequence.
+// Useful to simulate different outcomes out of the same position
+func (state *GameState) CloneWithSeed(seed int64) *GameState {
+	return state.clone(utils.NewRandSource(seed))
+}
+
+// clone copies the game structures directly rather than going through a snapshot. Subscriptions aren't carried over
+func (state *GameState) clone(source *utils.RandSource) *GameState {
+	clone := *state
+	clone.rand = rand.New(source)
+	clone.randSource = source
+	clone.bank = state.bank.Clone()
+	clone.board = state.board.Clone()
+	clone.bookKeeping = state.bookKeeping.Clone()
+	clone.development = state.development.Clone()
+	clone.dice = state.dice.Clone()
+	clone.round = state.round.Clone()
+	clone.trade = state.trade.Clone(clone.bookKeeping, clone.bank)
+
+	// Logs are only appended to, so both games share them until either one logs something
+	clone.logs = state.logs[:len(state.logs):len(state.logs)]
+	clone.actionLog = state.actionLog[:len(state.actionLog):len(state.actionLog)]
+	clone.commandDepth = 0
+	clone.subscriptions = nil
+	clone.pendingEvents = nil
+
+	clone.points = maps.Clone(state.points)
+	clone.tradeTokens = maps.Clone(state.tradeTokens)
+	clone.players = slices.Clone(state.players)
+	clone.playersStates = make(map[string]*player.Instance, len(state.playersStates))
+	for playerID, playerState := range state.playersStates {
+		clone.playersStates[playerID] = playerState.Clone()
+	}
+	clone.summary = summary.New(
+		clone.playersStates,
+		clone.GetSettings(),
+		clone.bookKeeping,
+	)
+	return &clone
+}
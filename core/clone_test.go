@@ -0,0 +1,124 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+)
+
+func TestClone(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playSetup(t, game)
+	before := string(snapshotJSON(t, game))
+
+	clone := game.Clone()
+	if string(snapshotJSON(t, clone)) != before {
+		t.Fatalf("expected clone to be identical to the original game")
+	}
+
+	t.Run("mutations on clone don't leak back", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			playerID := clone.CurrentRoundPlayer().ID
+			clone.RollDice(playerID)
+			if clone.RoundType() != round.Regular {
+				break
+			}
+			for _, action := range clone.LegalActions(playerID) {
+				if action.Type != CommandEndRound && action.Type != CommandUseDevelopmentCard {
+					clone.ApplyCommand(action.Command)
+					break
+				}
+			}
+			clone.EndRound(playerID)
+		}
+		if string(snapshotJSON(t, game)) != before {
+			t.Errorf("expected original game to be untouched after mutating the clone")
+		}
+	})
+
+	t.Run("clone continues the same random sequence", func(t *testing.T) {
+		other := game.Clone()
+		playerID := game.CurrentRoundPlayer().ID
+		game.RollDice(playerID)
+		other.RollDice(playerID)
+		if game.Dice() != other.Dice() {
+			t.Errorf("expected dice to be %v, but it's actually %v", game.Dice(), other.Dice())
+		}
+	})
+}
+
+func TestCloneWithSeed(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playSetup(t, game)
+	before := string(snapshotJSON(t, game))
+
+	first := game.CloneWithSeed(7)
+	second := game.CloneWithSeed(7)
+	playerID := game.CurrentRoundPlayer().ID
+	first.RollDice(playerID)
+	second.RollDice(playerID)
+	if first.Dice() != second.Dice() {
+		t.Errorf("expected clones with same seed to roll the same dice, but rolled %v and %v", first.Dice(), second.Dice())
+	}
+	if first.Seed() != 7 {
+		t.Errorf("expected clone seed to be 7, but it's actually %d", first.Seed())
+	}
+	if string(snapshotJSON(t, game)) != before {
+		t.Errorf("expected original game to be untouched after rolling dice on the clones")
+	}
+}
+
+func TestCloneWithoutTrackedRand(t *testing.T) {
+	game := CreateTestGameWithRand(StubRand(7), MockWithRoundType(round.BetweenTurns))
+	clone := game.Clone()
+	clone.RollDice("1")
+	if game.Dice() != [2]int{0, 0} {
+		t.Errorf("expected original game to not have rolled dice, but it has %v", game.Dice())
+	}
+}
+
+func TestCloneMidGame(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playRandomly(t, game, 42, 300)
+	clone := game.Clone()
+
+	snapshot := func(game *GameState) *Snapshot {
+		t.Helper()
+		snapshot, err := game.Snapshot()
+		if err != nil {
+			t.Fatalf("expected to snapshot game just fine, but actually got error %s", err.Error())
+		}
+		return snapshot
+	}
+	asJSON := func(value any) string {
+		t.Helper()
+		data, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("expected to marshal just fine, but actually got error %s", err.Error())
+		}
+		return string(data)
+	}
+
+	if asJSON(snapshot(clone)) != asJSON(snapshot(game)) {
+		t.Fatalf("expected clone to be identical to the original game, random generator position included")
+	}
+
+	// Longest road segments may be listed in either direction, so only the commands and the dice are compared
+	playRandomly(t, game, 7, 100)
+	playRandomly(t, clone, 7, 100)
+	gameSnapshot := snapshot(game)
+	cloneSnapshot := snapshot(clone)
+	if asJSON(cloneSnapshot.ActionLog) != asJSON(gameSnapshot.ActionLog) || cloneSnapshot.Rand != gameSnapshot.Rand {
+		t.Errorf("expected clone to play out just like the original game")
+	}
+}
+
+func BenchmarkClone(b *testing.B) {
+	game := createSeededTestGame(b, 42)
+	playRandomly(b, game, 42, 300)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		game.Clone()
+	}
+}
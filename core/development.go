@@ -7,20 +7,21 @@ import (
 	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
-func (state *GameState) BuyDevelopmentCard(playerID string) error {
+func (state *GameState) BuyDevelopmentCard(playerID string) (err error) {
+	defer state.record(Command{Type: CommandBuyDevelopmentCard, PlayerID: playerID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot buy development card during other player's round")
 		return err
 	}
 
-	if state.round.GetRoundType() != round.Regular {
+	if !state.isBuildingRound() {
 		err := fmt.Errorf("Cannot buy development card during %s", state.round.GetCurrentRoundTypeDescription())
 		return err
 	}
 
 	playerState := state.playersStates[playerID]
-	playerResources := playerState.GetResources()
-	if playerResources["Sheep"] < 1 || playerResources["Grain"] < 1 || playerResources["Ore"] < 1 {
+	if !playerState.GetResources().Covers(coreT.DevelopmentCardCost) {
 		err := fmt.Errorf("Cannot buy development card: insufficient resources")
 		return err
 	}
@@ -31,12 +32,7 @@ func (state *GameState) BuyDevelopmentCard(playerID string) error {
 	}
 	card.RoundBought = state.round.GetRoundNumber()
 
-	playerState.RemoveResource("Sheep", 1)
-	playerState.RemoveResource("Grain", 1)
-	playerState.RemoveResource("Ore", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Sheep", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Grain", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Ore", 1)
+	state.payToBank(playerID, coreT.DevelopmentCardCost)
 	playerState.AddDevelopmentCard(card)
 	state.bookKeeping.AddDevCardDrawn(playerID, card.Name)
 
@@ -47,7 +43,9 @@ func (state *GameState) BuyDevelopmentCard(playerID string) error {
 	return nil
 }
 
-func (state *GameState) UseDevelopmentCard(playerID, devCardType string) error {
+func (state *GameState) UseDevelopmentCard(playerID, devCardType string) (err error) {
+	defer state.record(Command{Type: CommandUseDevelopmentCard, PlayerID: playerID, DevelopmentCard: devCardType})(&err)
+
 	switch devCardType {
 	case "Knight":
 		return state.UseKnight(playerID)
@@ -66,8 +64,10 @@ func (state *GameState) UseDevelopmentCard(playerID, devCardType string) error {
 	}
 }
 
-func (state *GameState) UseKnight(playerID string) error {
-	err := state.consumeDevelopmentCardByPlayer(playerID, "Knight")
+func (state *GameState) UseKnight(playerID string) (err error) {
+	defer state.record(Command{Type: CommandUseKnight, PlayerID: playerID})(&err)
+
+	err = state.consumeDevelopmentCardByPlayer(playerID, "Knight")
 	if err != nil {
 		return err
 	}
@@ -83,8 +83,10 @@ func (state *GameState) UseKnight(playerID string) error {
 	return nil
 }
 
-func (state *GameState) UseMonopoly(playerID string) error {
-	err := state.consumeDevelopmentCardByPlayer(playerID, "Monopoly")
+func (state *GameState) UseMonopoly(playerID string) (err error) {
+	defer state.record(Command{Type: CommandUseMonopoly, PlayerID: playerID})(&err)
+
+	err = state.consumeDevelopmentCardByPlayer(playerID, "Monopoly")
 	if err != nil {
 		return err
 	}
@@ -93,7 +95,9 @@ func (state *GameState) UseMonopoly(playerID string) error {
 	return nil
 }
 
-func (state *GameState) PickMonopolyResource(playerID, resourceName string) error {
+func (state *GameState) PickMonopolyResource(playerID string, resource coreT.Resource) (err error) {
+	defer state.record(Command{Type: CommandPickMonopolyResource, PlayerID: playerID, Resource: resource})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot pick monopoly resource during other player's round")
 		return err
@@ -104,19 +108,22 @@ func (state *GameState) PickMonopolyResource(playerID, resourceName string) erro
 		return err
 	}
 
+	if err := resource.Validate(); err != nil {
+		err := fmt.Errorf("Cannot pick monopoly resource: %w", err)
+		return err
+	}
+
 	monopolyPlayerState := state.playersStates[playerID]
 
-	// TODO: make resource name typesafe
 	for _, player := range state.players {
 		if player.ID == playerID {
 			continue
 		}
 		playerState := state.playersStates[player.ID]
-		playerResources := playerState.GetResources()
-		quantity := playerResources[resourceName]
+		quantity := playerState.GetResources().Get(resource)
 		if quantity > 0 {
-			playerState.RemoveResource(resourceName, quantity)
-			monopolyPlayerState.AddResource(resourceName, quantity)
+			playerState.RemoveResource(resource, quantity)
+			monopolyPlayerState.AddResource(resource, quantity)
 		}
 	}
 
@@ -125,7 +132,9 @@ func (state *GameState) PickMonopolyResource(playerID, resourceName string) erro
 	return nil
 }
 
-func (state *GameState) UseRoadBuilding(playerID string) error {
+func (state *GameState) UseRoadBuilding(playerID string) (err error) {
+	defer state.record(Command{Type: CommandUseRoadBuilding, PlayerID: playerID})(&err)
+
 	playerState := state.playersStates[playerID]
 	playerRoads := playerState.GetRoads()
 	if len(playerRoads) >= state.maxRoads {
@@ -133,7 +142,7 @@ func (state *GameState) UseRoadBuilding(playerID string) error {
 		return err
 	}
 
-	err := state.consumeDevelopmentCardByPlayer(playerID, "Road Building")
+	err = state.consumeDevelopmentCardByPlayer(playerID, "Road Building")
 	if err != nil {
 		return err
 	}
@@ -142,7 +151,9 @@ func (state *GameState) UseRoadBuilding(playerID string) error {
 	return nil
 }
 
-func (state *GameState) PickRoadBuildingSpot(playerID string, edgeID int) error {
+func (state *GameState) PickRoadBuildingSpot(playerID string, edgeID int) (err error) {
+	defer state.record(Command{Type: CommandPickRoadBuildingSpot, PlayerID: playerID, EdgeID: edgeID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot pick road building spot during other player's round")
 		return err
@@ -157,8 +168,11 @@ func (state *GameState) PickRoadBuildingSpot(playerID string, edgeID int) error
 	// REFACTOR: this is repeating road.go code
 	edge, exists := state.board.GetRoads()[edgeID]
 	if exists {
-		owner := state.findPlayer(edge.Owner)
-		err := fmt.Errorf("Player %s already has road at edge #%d", owner, edgeID)
+		err := fmt.Errorf("Player %s already has road at edge #%d", edge.Owner, edgeID)
+		return err
+	}
+
+	if err := state.checkRoadOffShore(edgeID); err != nil {
 		return err
 	}
 
@@ -175,6 +189,9 @@ func (state *GameState) PickRoadBuildingSpot(playerID string, edgeID int) error
 	}
 	// END REFACTOR
 	state.handleNewRoad(playerID, edgeID)
+	if state.twoPlayer {
+		state.growNeutralRoad()
+	}
 
 	if state.round.GetRoundType() == round.BuildRoad2Development {
 		state.round.SetRoundType(round.Regular)
@@ -192,8 +209,15 @@ func (state *GameState) PickRoadBuildingSpot(playerID string, edgeID int) error
 	return nil
 }
 
-func (state *GameState) UseYearOfPlenty(playerID string) error {
-	err := state.consumeDevelopmentCardByPlayer(playerID, "Year of Plenty")
+func (state *GameState) UseYearOfPlenty(playerID string) (err error) {
+	defer state.record(Command{Type: CommandUseYearOfPlenty, PlayerID: playerID})(&err)
+
+	if !state.bankHasTwoResources() {
+		err := fmt.Errorf("Cannot use year of plenty: bank doesn't have two resources to give")
+		return err
+	}
+
+	err = state.consumeDevelopmentCardByPlayer(playerID, "Year of Plenty")
 	if err != nil {
 		return err
 	}
@@ -202,7 +226,9 @@ func (state *GameState) UseYearOfPlenty(playerID string) error {
 	return nil
 }
 
-func (state *GameState) PickYearOfPlentyResources(playerID, resource1, resource2 string) error {
+func (state *GameState) PickYearOfPlentyResources(playerID string, resource1, resource2 coreT.Resource) (err error) {
+	defer state.record(Command{Type: CommandPickYearOfPlentyResources, PlayerID: playerID, Resource: resource1, SecondResource: resource2})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot pick year of plenty resources during other player's round")
 		return err
@@ -213,9 +239,22 @@ func (state *GameState) PickYearOfPlentyResources(playerID, resource1, resource2
 		return err
 	}
 
-	playerState := state.playersStates[playerID]
-	playerState.AddResource(resource1, 1)
-	playerState.AddResource(resource2, 1)
+	for _, resource := range []coreT.Resource{resource1, resource2} {
+		if err := resource.Validate(); err != nil {
+			err := fmt.Errorf("Cannot pick year of plenty resources: %w", err)
+			return err
+		}
+	}
+
+	requested := coreT.ResourcesOf(resource1, 1).Add(coreT.ResourcesOf(resource2, 1))
+	if !state.bank.Has(requested) {
+		err := fmt.Errorf("Cannot pick year of plenty resources: bank doesn't have %s and %s", resource1, resource2)
+		return err
+	}
+
+	state.bank.Take(requested)
+	state.playersStates[playerID].AddResources(requested)
+	state.bookKeeping.AddResourcesDrawn(playerID, requested)
 	state.round.SetRoundType(round.Regular)
 	return nil
 }
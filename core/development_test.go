@@ -12,13 +12,13 @@ func TestBuyDevelopmentCardNotPlayerRound(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
 		MockWithCurrentRoundPlayer("2"),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 2,
-				"Brick":  2,
-				"Sheep":  2,
-				"Grain":  2,
-				"Ore":    2,
+				Lumber: 2,
+				Brick:  2,
+				Sheep:  2,
+				Grain:  2,
+				Ore:    2,
 			},
 		}),
 	)
@@ -33,13 +33,13 @@ func TestBuyDevelopmentCardNotPlayerRound(t *testing.T) {
 func TestBuyDevelopmentCardNotEnoughResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 2,
-				"Brick":  2,
-				"Sheep":  2,
-				"Grain":  2,
-				"Ore":    0,
+				Lumber: 2,
+				Brick:  2,
+				Sheep:  2,
+				Grain:  2,
+				Ore:    0,
 			},
 		}),
 	)
@@ -68,20 +68,20 @@ func TestPlayDevelopmentCardSameRoundBought(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -118,20 +118,20 @@ func TestPlayDevelopmentCardWithMultipleInHand(t *testing.T) {
 					}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -176,20 +176,20 @@ func TestPlayMultipleDevelopmentCardsSameRound(t *testing.T) {
 					}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -246,20 +246,20 @@ func TestPlayKnightDevelopmentCardRobOpponentWithCards(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -316,20 +316,20 @@ func TestPlayKnightDevelopmentCardRobOpponentWithNoCards(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 0,
-				"Brick":  0,
-				"Sheep":  0,
-				"Grain":  0,
-				"Ore":    0,
+				Lumber: 0,
+				Brick:  0,
+				Sheep:  0,
+				Grain:  0,
+				Ore:    0,
 			},
 		}),
 	)
@@ -352,8 +352,8 @@ func TestPlayKnightDevelopmentCardRobOpponentWithNoCards(t *testing.T) {
 		}
 
 		err = game.RobPlayer("1", "2")
-		if err == nil {
-			t.Errorf("expected to have error since player#2 has no cards, but actually no error was found")
+		if err != nil {
+			t.Errorf("expected to rob nothing from player#2 just fine, but actually got error %s", err.Error())
 		}
 		if game.round.GetRoundType() != round.Regular {
 			t.Errorf("expected round type to be %s, but it's actually %s", game.round.GetRoundTypeDescription(round.Regular), game.round.GetCurrentRoundTypeDescription())
@@ -386,13 +386,13 @@ func TestPlayKnightDevelopmentCardRobPlayerNotOnTile(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -445,13 +445,13 @@ func TestPlayKnightDevelopmentCardMoveRobberToTileOnlyOwnedByPlayer(t *testing.T
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -492,13 +492,13 @@ func TestPlayKnightDevelopmentCardWithoutHavingOne(t *testing.T) {
 				"Knight": {},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -574,25 +574,25 @@ func TestPlayMonopolyDevelopmentCardOpponentsHaveResource(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 3,
-				"Ore":    3,
+				Lumber: 3,
+				Ore:    3,
 			},
 			"3": {
-				"Lumber": 2,
-				"Ore":    2,
+				Lumber: 2,
+				Ore:    2,
 			},
 			"4": {
-				"Lumber": 3,
-				"Ore":    0,
+				Lumber: 3,
+				Ore:    0,
 			},
 		}),
 	)
@@ -648,25 +648,25 @@ func TestPlayMonopolyDevelopmentCardOpponentsDontHaveResource(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 3,
-				"Ore":    0,
+				Lumber: 3,
+				Ore:    0,
 			},
 			"3": {
-				"Lumber": 2,
-				"Ore":    0,
+				Lumber: 2,
+				Ore:    0,
 			},
 			"4": {
-				"Lumber": 3,
-				"Ore":    0,
+				Lumber: 3,
+				Ore:    0,
 			},
 		}),
 	)
@@ -1073,13 +1073,13 @@ func TestPlayYearOfPlentyDevelopmentCardAvailableResources(t *testing.T) {
 				}},
 			},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
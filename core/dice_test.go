@@ -0,0 +1,147 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/dice"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+func withDice(mode dice.Mode, reshuffleAt int) seededTestOption {
+	return withParams(func(params *Params) {
+		params.Dice = int(mode)
+		params.EventDeckReshuffle = reshuffleAt
+	})
+}
+
+func TestEventDeck(t *testing.T) {
+	t.Run("deck follows the dice distribution", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, withDice(dice.EventDeck, 0))
+		sums := make(map[int]int)
+		for i := 0; i < 36; i++ {
+			dice1, dice2 := game.dice.Roll(game.rand, "1")
+			sums[dice1+dice2]++
+		}
+		for sum, ways := range dice.Ways {
+			if sums[sum] != ways {
+				t.Errorf("expected %d to come up %d times out of 36, actually got %d", sum, ways, sums[sum])
+			}
+		}
+	})
+
+	t.Run("deck is reshuffled with cards left", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, withDice(dice.EventDeck, 5))
+		for i := 0; i < 31; i++ {
+			game.dice.Roll(game.rand, "1")
+		}
+		if cardsLeft := len(game.dice.Snapshot().Cards); cardsLeft != 5 {
+			t.Fatalf("expected 5 cards left, actually got %d", cardsLeft)
+		}
+		game.dice.Roll(game.rand, "1")
+		if cardsLeft := len(game.dice.Snapshot().Cards); cardsLeft != 35 {
+			t.Errorf("expected deck to be reshuffled and have 35 cards left, actually got %d", cardsLeft)
+		}
+	})
+
+	t.Run("cards adding up to the excluded sum stay in the deck", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, withDice(dice.EventDeck, 0))
+		game.dice.Roll(game.rand, "1")
+		top := game.dice.Snapshot().Cards[0]
+		dice1, dice2 := game.dice.RollExcluding(game.rand, "1", top[0]+top[1])
+		if dice1+dice2 == top[0]+top[1] {
+			t.Fatalf("expected to not draw a %d, actually got [%d %d]", top[0]+top[1], dice1, dice2)
+		}
+		cards := game.dice.Snapshot().Cards
+		if len(cards) != 34 || cards[0] != top {
+			t.Errorf("expected only the drawn card to leave the deck and %v to stay on top, actually got %d cards with %v on top", top, len(cards), cards[0])
+		}
+	})
+
+	t.Run("deck survives snapshots", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, withDice(dice.EventDeck, 5))
+		game.dice.Roll(game.rand, "1")
+		snapshot, _ := game.Snapshot()
+		restored, err := RestoreSnapshot(snapshot)
+		if err != nil {
+			t.Fatalf("expected to restore game just fine, but actually got error %s", err.Error())
+		}
+		for i := 0; i < 40; i++ {
+			dice1, dice2 := game.dice.Roll(game.rand, "1")
+			restoredDice1, restoredDice2 := restored.dice.Roll(restored.rand, "1")
+			if dice1 != restoredDice1 || dice2 != restoredDice2 {
+				t.Fatalf("expected roll #%d to be [%d %d] after restoring, actually got [%d %d]", i, dice1, dice2, restoredDice1, restoredDice2)
+			}
+		}
+	})
+}
+
+func TestBalancedDice(t *testing.T) {
+	r := utils.RandNew(42)
+	rollFrom := func(recent []dice.Roll, playerID string, times int) map[int]int {
+		sums := make(map[int]int)
+		for i := 0; i < times; i++ {
+			source := dice.FromSnapshot(dice.Snapshot{Mode: dice.Balanced, Recent: recent})
+			dice1, dice2 := source.Roll(r, playerID)
+			if dice1 < 1 || dice1 > 6 || dice2 < 1 || dice2 > 6 {
+				t.Fatalf("expected dice to be between 1 and 6, actually got [%d %d]", dice1, dice2)
+			}
+			sums[dice1+dice2]++
+		}
+		return sums
+	}
+
+	t.Run("over-rolled sums are less likely", func(t *testing.T) {
+		recent := make([]dice.Roll, 0)
+		for i := 0; i < 12; i++ {
+			recent = append(recent, dice.Roll{PlayerID: "2", Sum: 8})
+		}
+		sums := rollFrom(recent, "1", 1000)
+		// 139 out of 1000 with classic dice
+		if sums[8] > 60 {
+			t.Errorf("expected 8 to come up rarely after a streak of 8s, actually got it %d times out of 1000", sums[8])
+		}
+	})
+
+	t.Run("excluded sum is neither rolled nor remembered", func(t *testing.T) {
+		source := dice.FromSnapshot(dice.Snapshot{Mode: dice.Balanced})
+		for i := 0; i < 100; i++ {
+			dice1, dice2 := source.RollExcluding(r, "1", 7)
+			if dice1+dice2 == 7 {
+				t.Fatalf("expected to never roll a 7, actually got [%d %d]", dice1, dice2)
+			}
+		}
+		for _, roll := range source.Snapshot().Recent {
+			if roll.Sum == 7 {
+				t.Fatalf("expected no 7 to be remembered, actually got %v", source.Snapshot().Recent)
+			}
+		}
+	})
+
+	t.Run("a player that rolled many 7s is less likely to roll another", func(t *testing.T) {
+		recent := make([]dice.Roll, 0)
+		for i := 0; i < 4; i++ {
+			recent = append(recent, dice.Roll{PlayerID: "1", Sum: 7}, dice.Roll{PlayerID: "2", Sum: 6})
+		}
+		sevensByPlayer1 := rollFrom(recent, "1", 1000)[7]
+		sevensByPlayer2 := rollFrom(recent, "2", 1000)[7]
+		if sevensByPlayer1 >= sevensByPlayer2 {
+			t.Errorf("expected player 1 to roll less 7s than player 2, actually got %d and %d", sevensByPlayer1, sevensByPlayer2)
+		}
+	})
+}
+
+func TestDiceSourceInReport(t *testing.T) {
+	for mode, name := range dice.ModeNames {
+		game := createSeededTestGame(t, 42, withDice(mode, 5))
+		if game.GetReport().Statistics.DiceMode != name {
+			t.Errorf("expected report to say dice were %s, actually got %s", name, game.GetReport().Statistics.DiceMode)
+		}
+	}
+
+	t.Run("unknown dice source", func(t *testing.T) {
+		_, err := newSeededTestGame(42, withDice(3, 5))
+		if err == nil {
+			t.Errorf("expected to have error since dice source 3 doesn't exist, but actually no error was found")
+		}
+	})
+}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
-func (state *GameState) DiscardPlayerCards(playerID string, resources map[string]int) error {
+func (state *GameState) DiscardPlayerCards(playerID string, resources coreT.Resources) (err error) {
+	defer state.record(Command{Type: CommandDiscardPlayerCards, PlayerID: playerID, Resources: resources})(&err)
+
 	if state.round.GetRoundType() != round.DiscardPhase {
 		err := fmt.Errorf("Cannot discard cards during %s", state.round.GetCurrentRoundTypeDescription())
 		return err
@@ -24,26 +27,29 @@ func (state *GameState) DiscardPlayerCards(playerID string, resources map[string
 		return err
 	}
 
-	playerHand := playerState.GetResources()
-	discardingTotal := 0
+	if err := resources.Validate(); err != nil {
+		err := fmt.Errorf("Cannot discard cards: %w", err)
+		return err
+	}
 
-	for resource, quantity := range resources {
-		if quantity > playerHand[resource] {
+	playerHand := playerState.GetResources()
+	for _, resource := range ResourcesOrder {
+		quantity := resources.Get(resource)
+		if quantity > playerHand.Get(resource) {
 			err := fmt.Errorf("Cannot discard %d %s: doesn't have that amount", quantity, resource)
 			return err
 		}
-		discardingTotal += quantity
 	}
 
+	discardingTotal := resources.Total()
 	if discardingTotal != playerDiscardAmount {
 		err := fmt.Errorf("Cannot discard %d cards: must discard %d", discardingTotal, playerDiscardAmount)
 		return err
 	}
 
-	for resource, quantity := range resources {
-		playerState.RemoveResource(resource, quantity)
-		state.bookKeeping.AddResourceDiscarded(playerID, resource, quantity)
-	}
+	playerState.RemoveResources(resources)
+	state.bank.Return(resources)
+	state.bookKeeping.AddResourcesDiscarded(playerID, resources)
 	playerState.SetHasDiscardedThisTurn(true)
 
 	for _, player := range state.players {
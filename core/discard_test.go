@@ -4,19 +4,20 @@ import (
 	"testing"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestEnterDiscardPhaseAfter7AndAPlayerHasTooMuchCards(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -38,20 +39,20 @@ func TestDiscardPlayerCardsDoesntNeedToDiscardError(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -59,8 +60,8 @@ func TestDiscardPlayerCardsDoesntNeedToDiscardError(t *testing.T) {
 
 	t.Run("discard attempt, doesn't need to discard", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 1,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to have error due to discarding while not needing it, but actually discarded just fine")
@@ -72,13 +73,13 @@ func TestDiscardPlayerAlreadyDiscardedError(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -86,18 +87,18 @@ func TestDiscardPlayerAlreadyDiscardedError(t *testing.T) {
 
 	t.Run("discard attempt, trying to discard twice the same turn", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-			"Sheep":  1,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+			Sheep:  1,
 		})
 		if err != nil {
 			t.Errorf("expected to discard resources correctly, but actually got error %s", err.Error())
 		}
 
-		err = game.DiscardPlayerCards("1", map[string]int{
-			"Sheep": 2,
-			"Grain": 2,
+		err = game.DiscardPlayerCards("1", coreT.Resources{
+			Sheep: 2,
+			Grain: 2,
 		})
 		if err == nil {
 			t.Errorf("expected to have error due to discarding multiple times the same round, but actually discarded just fine")
@@ -110,13 +111,13 @@ func TestDiscardPlayerTryToDiscardMoreResourceThanPossessedError(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -124,9 +125,9 @@ func TestDiscardPlayerTryToDiscardMoreResourceThanPossessedError(t *testing.T) {
 
 	t.Run("discard attempt, tries to discard more resources than possessed", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  4,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  4,
 		})
 		if err == nil {
 			t.Errorf("expected to have error due to discarding more bricks than possessed, but actually discarded just fine")
@@ -138,13 +139,13 @@ func TestDiscardPlayerTryToDiscardLessResourcesThanRequiredError(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -152,9 +153,9 @@ func TestDiscardPlayerTryToDiscardLessResourcesThanRequiredError(t *testing.T) {
 
 	t.Run("discard attempt, tries to discard less resources than required", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
 		})
 		if err == nil {
 			t.Errorf("expected to have error due to discarding less resources than required, but actually discarded just fine")
@@ -166,13 +167,13 @@ func TestDiscardPlayerTryToDiscardMoreResourcesThanRequiredError(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -180,10 +181,10 @@ func TestDiscardPlayerTryToDiscardMoreResourcesThanRequiredError(t *testing.T) {
 
 	t.Run("discard attempt, tries to discard more resources than required", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-			"Sheep":  2,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+			Sheep:  2,
 		})
 		if err == nil {
 			t.Errorf("expected to have error due to discarding more resources than required, but actually discarded just fine")
@@ -195,13 +196,13 @@ func TestDiscardPlayerTryToDiscardMoreR(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -209,10 +210,10 @@ func TestDiscardPlayerTryToDiscardMoreR(t *testing.T) {
 
 	t.Run("discard attempt, tries to discard more resources than required", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-			"Sheep":  2,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+			Sheep:  2,
 		})
 		if err == nil {
 			t.Errorf("expected to have error due to discarding more resources than required, but actually discarded just fine")
@@ -224,13 +225,13 @@ func TestDiscardPlayerSuccess(t *testing.T) {
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -238,10 +239,10 @@ func TestDiscardPlayerSuccess(t *testing.T) {
 
 	t.Run("discard attempt, discards correctly", func(t *testing.T) {
 		game.RollDice("1")
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-			"Sheep":  1,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+			Sheep:  1,
 		})
 		if err != nil {
 			t.Errorf("expected to discard resources correctly, but actually got error %s", err.Error())
@@ -249,20 +250,20 @@ func TestDiscardPlayerSuccess(t *testing.T) {
 
 		player1Resources := game.ResourceHandByPlayer("1")
 
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 0 {
-			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 0 {
+			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 2 {
-			t.Errorf("expected player#1 to have 2 Sheep, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 2 {
+			t.Errorf("expected player#1 to have 2 Sheep, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 3 {
-			t.Errorf("expected player#1 to have 3 Grain, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 3 {
+			t.Errorf("expected player#1 to have 3 Grain, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 3 {
-			t.Errorf("expected player#1 to have 3 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 3 {
+			t.Errorf("expected player#1 to have 3 Ore, actually got %d", player1Resources.Ore)
 		}
 
 		if game.round.GetRoundType() != round.MoveRobberDue7 {
@@ -275,20 +276,20 @@ func TestDiscardPlayerChangeToMoveRobberRoundAfterLastRequiredPlayerDiscards(t *
 	rand := StubRand(7)
 	game := CreateTestGame(
 		MockWithRoundType(round.BetweenTurns),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 			"2": {
-				"Lumber": 3,
-				"Brick":  3,
-				"Sheep":  3,
-				"Grain":  3,
-				"Ore":    3,
+				Lumber: 3,
+				Brick:  3,
+				Sheep:  3,
+				Grain:  3,
+				Ore:    3,
 			},
 		}),
 		MockWithRand(rand),
@@ -299,10 +300,10 @@ func TestDiscardPlayerChangeToMoveRobberRoundAfterLastRequiredPlayerDiscards(t *
 		t.Log("Player2", game.DiscardAmountByPlayer("2"))
 		t.Log("Player3", game.DiscardAmountByPlayer("3"))
 		t.Log("Player4", game.DiscardAmountByPlayer("4"))
-		err := game.DiscardPlayerCards("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-			"Sheep":  1,
+		err := game.DiscardPlayerCards("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+			Sheep:  1,
 		})
 		if err != nil {
 			t.Errorf("expected to discard resources correctly, but actually got error %s", err.Error())
@@ -311,10 +312,10 @@ func TestDiscardPlayerChangeToMoveRobberRoundAfterLastRequiredPlayerDiscards(t *
 			t.Errorf("expected round type to be %s, but it's actually %s", game.round.GetRoundTypeDescription(round.DiscardPhase), game.round.GetCurrentRoundTypeDescription())
 		}
 
-		err = game.DiscardPlayerCards("2", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-			"Sheep":  1,
+		err = game.DiscardPlayerCards("2", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+			Sheep:  1,
 		})
 		if err != nil {
 			t.Errorf("expected to discard resources correctly, but actually got error %s", err.Error())
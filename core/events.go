@@ -0,0 +1,185 @@
+package core
+
+import coreT "github.com/victoroliveirab/settlers/core/types"
+
+type EventType string
+
+const (
+	EventResourcesProduced  EventType = "ResourcesProduced"
+	EventResourcesBlocked   EventType = "ResourcesBlocked"
+	EventGoldPicked         EventType = "GoldPicked"
+	EventTileExplored       EventType = "TileExplored"
+	EventSettlementBuilt    EventType = "SettlementBuilt"
+	EventCityBuilt          EventType = "CityBuilt"
+	EventRoadBuilt          EventType = "RoadBuilt"
+	EventShipBuilt          EventType = "ShipBuilt"
+	EventShipMoved          EventType = "ShipMoved"
+	EventLongestRoadChanged EventType = "LongestRoadChanged"
+	EventLargestArmyChanged EventType = "LargestArmyChanged"
+	EventPlayerRobbed       EventType = "PlayerRobbed"
+	EventTradeFinalized     EventType = "TradeFinalized"
+	EventTradeForced        EventType = "TradeForced"
+	EventGameEnded          EventType = "GameEnded"
+)
+
+// Event is a fact about something that happened in the game.
+// Subscribers tell events apart with a type switch over the structs below
+type Event interface {
+	Type() EventType
+}
+
+// ResourcesProduced is emitted once per player receiving resources from the bank,
+// be it from a dice roll or the initial resources after setup
+type ResourcesProduced struct {
+	PlayerID  string
+	Resources coreT.Resources
+}
+
+// ResourcesBlocked is emitted once per player who would have produced resources from a tile blocked by the robber
+type ResourcesBlocked struct {
+	PlayerID  string
+	Resources coreT.Resources
+}
+
+// GoldPicked is emitted once per player picking the resources produced by gold fields
+type GoldPicked struct {
+	PlayerID  string
+	Resources coreT.Resources
+}
+
+// TileExplored is emitted when a player builds next to a hidden tile, revealing it to everyone.
+// Resources is what the explorer got for it, if anything
+type TileExplored struct {
+	PlayerID  string
+	TileID    int
+	Resources coreT.Resources
+}
+
+type SettlementBuilt struct {
+	PlayerID string
+	VertexID int
+}
+
+type CityBuilt struct {
+	PlayerID string
+	VertexID int
+}
+
+type RoadBuilt struct {
+	PlayerID string
+	EdgeID   int
+}
+
+type ShipBuilt struct {
+	PlayerID string
+	EdgeID   int
+}
+
+type ShipMoved struct {
+	PlayerID   string
+	FromEdgeID int
+	ToEdgeID   int
+}
+
+// LongestRoadChanged is emitted when the longest road award changes hands.
+// PlayerID is empty when the award is revoked
+type LongestRoadChanged struct {
+	PreviousPlayerID string
+	PlayerID         string
+	Length           int
+}
+
+// LargestArmyChanged is emitted when the most knights award changes hands
+type LargestArmyChanged struct {
+	PreviousPlayerID string
+	PlayerID         string
+	Quantity         int
+}
+
+// PlayerRobbed is emitted when the robber takes a card. Resource is empty when the target had none
+type PlayerRobbed struct {
+	PlayerID       string
+	TargetPlayerID string
+	Resource       coreT.Resource
+}
+
+// TradeFinalized is emitted when a trade between players goes through:
+// the requester gave Offer and the accepter gave Request
+type TradeFinalized struct {
+	TradeID     int
+	RequesterID string
+	AccepterID  string
+	Offer       coreT.Resources
+	Request     coreT.Resources
+}
+
+// TradeForced is emitted when a player spends trade tokens in the two-player variant
+// to take Taken at random from the target, giving Given back
+type TradeForced struct {
+	PlayerID       string
+	TargetPlayerID string
+	Taken          coreT.Resources
+	Given          coreT.Resources
+}
+
+// GameEnded carries the final points. WinnerID is empty if the game ended before anyone reached the target
+type GameEnded struct {
+	WinnerID string
+	Points   map[string]int
+}
+
+func (ResourcesProduced) Type() EventType  { return EventResourcesProduced }
+func (ResourcesBlocked) Type() EventType   { return EventResourcesBlocked }
+func (GoldPicked) Type() EventType         { return EventGoldPicked }
+func (TileExplored) Type() EventType       { return EventTileExplored }
+func (SettlementBuilt) Type() EventType    { return EventSettlementBuilt }
+func (CityBuilt) Type() EventType          { return EventCityBuilt }
+func (RoadBuilt) Type() EventType          { return EventRoadBuilt }
+func (ShipBuilt) Type() EventType          { return EventShipBuilt }
+func (ShipMoved) Type() EventType          { return EventShipMoved }
+func (LongestRoadChanged) Type() EventType { return EventLongestRoadChanged }
+func (LargestArmyChanged) Type() EventType { return EventLargestArmyChanged }
+func (PlayerRobbed) Type() EventType       { return EventPlayerRobbed }
+func (TradeFinalized) Type() EventType     { return EventTradeFinalized }
+func (TradeForced) Type() EventType        { return EventTradeForced }
+func (GameEnded) Type() EventType          { return EventGameEnded }
+
+type subscription struct {
+	handler func(Event)
+}
+
+// Subscribe calls handler with every event emitted from now on, until unsubscribe is called.
+// Events are delivered once the command emitting them is done, so handlers see the resulting state.
+// Clones don't inherit subscriptions
+func (state *GameState) Subscribe(handler func(Event)) (unsubscribe func()) {
+	sub := &subscription{handler: handler}
+	state.subscriptions = append(state.subscriptions, sub)
+	return func() {
+		for i, candidate := range state.subscriptions {
+			if candidate == sub {
+				state.subscriptions = append(state.subscriptions[:i:i], state.subscriptions[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// emit queues event until the outermost command is done. Outside of commands (e.g. mocks), it's delivered right away
+func (state *GameState) emit(event Event) {
+	state.pendingEvents = append(state.pendingEvents, event)
+	if state.commandDepth == 0 {
+		state.flushEvents()
+	}
+}
+
+func (state *GameState) flushEvents() {
+	// Handlers may issue commands of their own, which emit and flush their own events
+	events := state.pendingEvents
+	state.pendingEvents = nil
+	subscriptions := append([]*subscription(nil), state.subscriptions...)
+	for _, event := range events {
+		for _, sub := range subscriptions {
+			sub.handler(event)
+		}
+	}
+}
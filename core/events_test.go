@@ -0,0 +1,124 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func TestEventsResourcesProduced(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playSetup(t, game)
+
+	for i := 0; i < 20; i++ {
+		playerID := game.CurrentRoundPlayer().ID
+		before := make(map[string]coreT.Resources)
+		for _, player := range game.Players() {
+			before[player.ID] = game.ResourceHandByPlayer(player.ID)
+		}
+
+		produced := make(map[string]coreT.Resources)
+		unsubscribe := game.Subscribe(func(event Event) {
+			if event, ok := event.(ResourcesProduced); ok {
+				produced[event.PlayerID] = produced[event.PlayerID].Add(event.Resources)
+			}
+		})
+		if err := game.RollDice(playerID); err != nil {
+			t.Fatalf("expected player#%s to roll dice just fine, but actually got error %s", playerID, err.Error())
+		}
+		unsubscribe()
+
+		for _, player := range game.Players() {
+			diff := game.ResourceHandByPlayer(player.ID).Subtract(before[player.ID])
+			if diff != produced[player.ID] {
+				t.Errorf("expected player#%s to have produced %s, but events reported %s", player.ID, diff, produced[player.ID])
+			}
+		}
+		if game.RoundType() != round.Regular {
+			// Non trivial rounds (robber, discard) are out of scope of this test
+			break
+		}
+		game.EndRound(playerID)
+	}
+}
+
+func TestEventsUnsubscribe(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+
+	received := 0
+	unsubscribe := game.Subscribe(func(event Event) {
+		received++
+	})
+	playSetup(t, game)
+	if received == 0 {
+		t.Fatalf("expected to receive events during setup, but actually received none")
+	}
+
+	unsubscribe()
+	received = 0
+	game.RollDice(game.CurrentRoundPlayer().ID)
+	if received != 0 {
+		t.Errorf("expected to receive no events after unsubscribing, but actually received %d", received)
+	}
+}
+
+func TestEventsLongestRoadChanged(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+		MockWithSettlementsByPlayer(map[string][]int{
+			"1": {1},
+		}),
+		MockWithRoadsByPlayer(map[string][]int{
+			"1": {1, 2, 7, 8},
+		}),
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
+			"1": {
+				Lumber: 1,
+				Brick:  1,
+			},
+		}),
+	)
+
+	events := make([]Event, 0)
+	game.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	err := game.BuildRoad("1", 12)
+	if err != nil {
+		t.Fatalf("expected to build road just fine, but actually got error %s", err.Error())
+	}
+
+	expected := []Event{
+		RoadBuilt{PlayerID: "1", EdgeID: 12},
+		LongestRoadChanged{PreviousPlayerID: "", PlayerID: "1", Length: 5},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected events %+v, but actually got %+v", expected, events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Errorf("expected event #%d to be %+v, but actually got %+v", i, expected[i], events[i])
+		}
+	}
+}
+
+func TestEventsDeliveredAfterCommand(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+
+	var roundType round.Type
+	game.Subscribe(func(event Event) {
+		if _, ok := event.(SettlementBuilt); ok {
+			roundType = game.RoundType()
+		}
+	})
+	playerID := game.CurrentRoundPlayer().ID
+	vertices, _ := game.AvailableVertices(playerID)
+	if err := game.BuildSettlement(playerID, vertices[0]); err != nil {
+		t.Fatalf("expected player#%s to build settlement just fine, but actually got error %s", playerID, err.Error())
+	}
+	if roundType != round.SetupRoad1 {
+		t.Errorf("expected handler to see the state after the command (round type %d), but actually saw round type %d", round.SetupRoad1, roundType)
+	}
+}
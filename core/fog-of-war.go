@@ -0,0 +1,74 @@
+package core
+
+import (
+	"fmt"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// In fog-of-war games only the map starting region is revealed at first. The other tiles stay hidden, resource
+// and token unknown, until a player builds a road, ship or settlement next to them, which reveals them to everyone
+
+func (state *GameState) hideUnexploredTiles() error {
+	startingRegion := state.board.Definition.StartingRegion
+	if len(startingRegion) == 0 {
+		err := fmt.Errorf("Cannot play with fog of war on %s: map has no starting region", state.board.MapName)
+		return err
+	}
+	for i, tile := range state.board.GetTiles() {
+		// The robber starts on a known tile, otherwise it would give the desert away
+		if utils.SliceContains(startingRegion, tile.ID) || tile.Blocked {
+			continue
+		}
+		state.board.HideTileByIndex(i)
+	}
+	return nil
+}
+
+func (state *GameState) HiddenTiles() []int {
+	return state.board.GetHiddenTilesIDs()
+}
+
+func (state *GameState) isTileHidden(tileID int) bool {
+	return utils.SliceContains(state.board.GetHiddenTilesIDs(), tileID)
+}
+
+func (state *GameState) exploreAroundEdge(playerID string, edgeID int) {
+	for i, tile := range state.board.GetTiles() {
+		if tile.Hidden && utils.SliceContains(tile.Edges[:], edgeID) {
+			state.exploreTile(playerID, i)
+		}
+	}
+}
+
+func (state *GameState) exploreAroundVertex(playerID string, vertexID int) {
+	tiles := state.board.GetTiles()
+	for _, index := range state.board.Definition.TilesByVertex[vertexID] {
+		if tiles[index].Hidden {
+			state.exploreTile(playerID, index)
+		}
+	}
+}
+
+// exploreTile reveals the tile at index. With the exploration reward, the explorer also gets a card of its
+// resource, as long as the bank has one
+func (state *GameState) exploreTile(playerID string, index int) {
+	state.board.RevealTileByIndex(index)
+	tile := state.board.GetTiles()[index]
+
+	reward := coreT.Resources{}
+	resource := coreT.Resource(tile.Resource)
+	if state.explorationReward && resource.Validate() == nil {
+		reward = coreT.ResourcesOf(resource, 1)
+		if !state.bank.Has(reward) {
+			reward = coreT.Resources{}
+		}
+	}
+	if reward.Total() > 0 {
+		state.bank.Take(reward)
+		state.playersStates[playerID].AddResources(reward)
+		state.bookKeeping.AddResourcesFromExploring(playerID, reward)
+	}
+	state.emit(TileExplored{PlayerID: playerID, TileID: tile.ID, Resources: reward})
+}
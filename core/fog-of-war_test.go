@@ -0,0 +1,152 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+func withFogOfWar(fogOfWar int) seededTestOption {
+	return withParams(func(params *Params) { params.FogOfWar = fogOfWar })
+}
+
+func firstHiddenTile(t *testing.T, game *GameState) coreT.MapBlock {
+	hidden := game.HiddenTiles()
+	if len(hidden) == 0 {
+		t.Fatalf("expected game to have hidden tiles")
+	}
+	for _, tile := range game.board.GetTiles() {
+		if tile.ID == hidden[0] {
+			return tile
+		}
+	}
+	return coreT.MapBlock{}
+}
+
+// On islands4, the starting region is the main island, tiles #1 to #10
+func TestFogOfWarStart(t *testing.T) {
+	game := createSeededTestGame(t, 42, islands4, withFogOfWar(1))
+
+	for _, tile := range game.GetBoard() {
+		inStartingRegion := tile.ID <= 10
+		if inStartingRegion && tile.Hidden {
+			t.Errorf("expected tile#%d of the starting region to be revealed", tile.ID)
+		}
+		if !inStartingRegion && !tile.Hidden && !tile.Blocked {
+			t.Errorf("expected tile#%d out of the starting region to be hidden", tile.ID)
+		}
+		if tile.Hidden && (tile.Resource != coreMaps.HiddenResource || tile.Token != 0) {
+			t.Errorf("expected hidden tile#%d to have neither resource nor token, actually got %s %d", tile.ID, tile.Resource, tile.Token)
+		}
+	}
+
+	t.Run("robber starts on a revealed tile", func(t *testing.T) {
+		for _, tileID := range game.BlockedTiles() {
+			if slices.Contains(game.HiddenTiles(), tileID) {
+				t.Errorf("expected robber tile#%d to be revealed", tileID)
+			}
+		}
+	})
+
+	t.Run("fog of war is off by default", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, islands4)
+		if len(game.HiddenTiles()) != 0 {
+			t.Errorf("expected every tile to be revealed, actually got hidden tiles %v", game.HiddenTiles())
+		}
+	})
+
+	t.Run("maps without starting region can't be played with fog of war", func(t *testing.T) {
+		_, err := newSeededTestGame(42, withFogOfWar(1))
+		if err == nil {
+			t.Errorf("expected to not be able to play base4 with fog of war")
+		}
+	})
+}
+
+func TestExploreTiles(t *testing.T) {
+	setup := func(t *testing.T, fogOfWar int) (*GameState, coreT.MapBlock) {
+		game := createSeededTestGame(t, 42, islands4, withFogOfWar(fogOfWar))
+		tile := firstHiddenTile(t, game)
+		MockWithRoundType(round.Regular)(game)
+		MockWithSettlementsByPlayer(map[string][]int{"1": {tile.Vertices[0]}})(game)
+		MockWithResourcesByPlayer(map[string]coreT.Resources{"1": {Lumber: 1, Brick: 1}})(game)
+		return game, tile
+	}
+
+	t.Run("building a road reveals the tiles around it", func(t *testing.T) {
+		game, tile := setup(t, 1)
+		hiddenBefore := len(game.HiddenTiles())
+
+		err := game.BuildRoad("1", tile.Edges[0])
+		if err != nil {
+			t.Fatalf("expected to build road just fine, but actually got error %s", err.Error())
+		}
+		if slices.Contains(game.HiddenTiles(), tile.ID) {
+			t.Errorf("expected tile#%d to be revealed", tile.ID)
+		}
+		if len(game.HiddenTiles()) >= hiddenBefore {
+			t.Errorf("expected less than %d hidden tiles, actually got %d", hiddenBefore, len(game.HiddenTiles()))
+		}
+		if game.ResourceHandByPlayer("1").Total() != 0 {
+			t.Errorf("expected no exploration reward, actually got %v", game.ResourceHandByPlayer("1"))
+		}
+	})
+
+	t.Run("exploration reward", func(t *testing.T) {
+		game, tile := setup(t, 2)
+		hiddenBefore := game.HiddenTiles()
+		game.BuildRoad("1", tile.Edges[0])
+
+		expected := coreT.Resources{}
+		for _, revealed := range game.board.GetTiles() {
+			if !slices.Contains(hiddenBefore, revealed.ID) || revealed.Hidden {
+				continue
+			}
+			resource := coreT.Resource(revealed.Resource)
+			if resource.Validate() == nil {
+				expected = expected.Add(coreT.ResourcesOf(resource, 1))
+			}
+		}
+		if game.ResourceHandByPlayer("1") != expected {
+			t.Errorf("expected player 1 to get %v for exploring, actually got %v", expected, game.ResourceHandByPlayer("1"))
+		}
+		if game.bookKeeping.GetResourcesFromExploringByPlayer()["1"] != expected {
+			t.Errorf("expected book keeping to record %v from exploring, actually got %v", expected, game.bookKeeping.GetResourcesFromExploringByPlayer()["1"])
+		}
+	})
+
+	t.Run("hidden tiles survive snapshots", func(t *testing.T) {
+		game, tile := setup(t, 1)
+		game.BuildRoad("1", tile.Edges[0])
+		snapshot, err := game.Snapshot()
+		if err != nil {
+			t.Fatalf("expected to snapshot game just fine, but actually got error %s", err.Error())
+		}
+		restored, err := RestoreSnapshot(snapshot)
+		if err != nil {
+			t.Fatalf("expected to restore game just fine, but actually got error %s", err.Error())
+		}
+		if !slices.Equal(restored.HiddenTiles(), game.HiddenTiles()) {
+			t.Errorf("expected restored hidden tiles to be %v, actually got %v", game.HiddenTiles(), restored.HiddenTiles())
+		}
+	})
+}
+
+func TestRobberAvoidsHiddenTiles(t *testing.T) {
+	game := createSeededTestGame(t, 42, islands4, withFogOfWar(1), withMocks(MockWithRoundType(round.MoveRobberDue7)))
+	hiddenTileID := game.HiddenTiles()[0]
+
+	for _, action := range game.LegalActions("1") {
+		if action.Type == CommandMoveRobber && utils.SliceContains(game.HiddenTiles(), action.TileID) {
+			t.Errorf("expected robber to not be able to move to hidden tile#%d", action.TileID)
+		}
+	}
+	err := game.MoveRobber("1", hiddenTileID)
+	if err == nil {
+		t.Errorf("expected to not be able to move robber to hidden tile#%d", hiddenTileID)
+	}
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// Gold fields produce no resource of their own: when their token is rolled, every player with buildings around
+// picks any resource from the bank, one per settlement and two per city, during the GoldPick round
+
+func (state *GameState) PickGoldResources(playerID string, resources coreT.Resources) (err error) {
+	defer state.record(Command{Type: CommandPickGoldResources, PlayerID: playerID, Resources: resources})(&err)
+
+	if state.round.GetRoundType() != round.GoldPick {
+		err := fmt.Errorf("Cannot pick gold resources during %s", state.round.GetCurrentRoundTypeDescription())
+		return err
+	}
+
+	amount := state.GoldPickAmountByPlayer(playerID)
+	if amount == 0 {
+		err := fmt.Errorf("Cannot pick gold resources: player has nothing to pick")
+		return err
+	}
+
+	if err := resources.Validate(); err != nil {
+		err := fmt.Errorf("Cannot pick gold resources: %w", err)
+		return err
+	}
+
+	pickingTotal := resources.Total()
+	if pickingTotal != amount {
+		err := fmt.Errorf("Cannot pick %d gold resources: must pick %d", pickingTotal, amount)
+		return err
+	}
+
+	if !state.bank.Has(resources) {
+		err := fmt.Errorf("Cannot pick gold resources: bank doesn't have %s", resources)
+		return err
+	}
+
+	playerState := state.playersStates[playerID]
+	state.bank.Take(resources)
+	playerState.AddResources(resources)
+	playerState.SetGoldPickAmount(0)
+	state.bookKeeping.AddResourcesFromGold(playerID, resources)
+	state.emit(GoldPicked{PlayerID: playerID, Resources: resources})
+
+	state.endGoldPickIfDone()
+	return nil
+}
+
+// GoldPickAmountByPlayer is how many resources the player must pick, capped by what is left in the bank
+func (state *GameState) GoldPickAmountByPlayer(playerID string) int {
+	if state.round.GetRoundType() != round.GoldPick {
+		return 0
+	}
+	playerState, exists := state.playersStates[playerID]
+	if !exists {
+		return 0
+	}
+	return min(playerState.GetGoldPickAmount(), state.bank.GetResources().Total())
+}
+
+func (state *GameState) GoldPickAmounts() map[string]int {
+	amounts := map[string]int{}
+	for _, player := range state.players {
+		amounts[player.ID] = state.GoldPickAmountByPlayer(player.ID)
+	}
+	return amounts
+}
+
+// addGoldToPick owes a pick to the owners of the buildings around a rolled gold field.
+// A gold field blocked by the robber produces nothing, like any other tile
+func (state *GameState) addGoldToPick(tile coreT.MapBlock) {
+	if tile.Blocked {
+		return
+	}
+	for _, player := range state.players {
+		playerState := state.playersStates[player.ID]
+		owed := 0
+		for _, vertexID := range tile.Vertices {
+			if utils.SliceContains(playerState.GetSettlements(), vertexID) {
+				owed += 1
+			}
+			if utils.SliceContains(playerState.GetCities(), vertexID) {
+				owed += 2
+			}
+		}
+		if owed > 0 {
+			playerState.SetGoldPickAmount(playerState.GetGoldPickAmount() + owed)
+		}
+	}
+}
+
+func (state *GameState) hasGoldToPick() bool {
+	for _, player := range state.players {
+		if state.playersStates[player.ID].GetGoldPickAmount() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// endGoldPickIfDone leaves GoldPick once nobody has anything left to pick, be it because everyone picked
+// or because the bank ran out
+func (state *GameState) endGoldPickIfDone() {
+	for _, player := range state.players {
+		if state.GoldPickAmountByPlayer(player.ID) > 0 {
+			return
+		}
+	}
+	for _, player := range state.players {
+		state.playersStates[player.ID].SetGoldPickAmount(0)
+	}
+	state.resumeAfterProduction()
+}
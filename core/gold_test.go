@@ -0,0 +1,135 @@
+package core
+
+import (
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func goldTile(t *testing.T, game *GameState) coreT.MapBlock {
+	for _, tile := range game.board.GetTiles() {
+		if tile.Resource == coreMaps.GoldResource {
+			return tile
+		}
+	}
+	t.Fatalf("expected islands4 to have a gold field")
+	return coreT.MapBlock{}
+}
+
+// Player 1 has a settlement and player 2 a city around the gold field. Player 3 has nothing around it
+func createGoldTestGame(t *testing.T, opts ...GameStateOption) (*GameState, coreT.MapBlock) {
+	game := createSeededTestGame(t, 42, islands4)
+	tile := goldTile(t, game)
+	opts = append([]GameStateOption{
+		MockWithRoundType(round.BetweenTurns),
+		MockWithSettlementsByPlayer(map[string][]int{"1": {tile.Vertices[0]}}),
+		MockWithCitiesByPlayer(map[string][]int{"2": {tile.Vertices[3]}}),
+		MockWithRand(StubRand(tile.Token)),
+	}, opts...)
+	for _, opt := range opts {
+		opt(game)
+	}
+	return game, tile
+}
+
+func TestGoldFieldRolled(t *testing.T) {
+	game, _ := createGoldTestGame(t)
+	err := game.RollDice("1")
+	if err != nil {
+		t.Fatalf("expected to roll dice just fine, but actually got error %s", err.Error())
+	}
+
+	if game.RoundType() != round.GoldPick {
+		t.Errorf("expected round type to be GoldPick, actually got %s", round.RoundTypeTranslation[game.RoundType()])
+	}
+	expectedAmounts := map[string]int{"1": 1, "2": 2, "3": 0}
+	for playerID, expected := range expectedAmounts {
+		if game.GoldPickAmountByPlayer(playerID) != expected {
+			t.Errorf("expected player %s to pick %d gold resources, actually got %d", playerID, expected, game.GoldPickAmountByPlayer(playerID))
+		}
+	}
+
+	legalActions := game.LegalActions("2")
+	if len(legalActions) != 1 || legalActions[0].Type != CommandPickGoldResources || legalActions[0].GoldPickAmount != 2 {
+		t.Errorf("expected player 2 to only be able to pick 2 gold resources, actually got %v", legalActions)
+	}
+	if len(game.LegalActions("3")) != 0 {
+		t.Errorf("expected player 3 to have nothing to do, actually got %v", game.LegalActions("3"))
+	}
+}
+
+func TestPickGoldResources(t *testing.T) {
+	t.Run("must pick exactly the amount owed", func(t *testing.T) {
+		game, _ := createGoldTestGame(t)
+		game.RollDice("1")
+		err := game.PickGoldResources("2", coreT.Resources{Ore: 1})
+		if err == nil {
+			t.Errorf("expected to not be able to pick less than owed")
+		}
+		err = game.PickGoldResources("2", coreT.Resources{Ore: 3})
+		if err == nil {
+			t.Errorf("expected to not be able to pick more than owed")
+		}
+		err = game.PickGoldResources("3", coreT.Resources{Ore: 1})
+		if err == nil {
+			t.Errorf("expected player without buildings around the gold field to not be able to pick")
+		}
+	})
+
+	t.Run("cannot pick outside GoldPick", func(t *testing.T) {
+		game, _ := createGoldTestGame(t)
+		err := game.PickGoldResources("1", coreT.Resources{Ore: 1})
+		if err == nil {
+			t.Errorf("expected to not be able to pick gold resources before rolling the dice")
+		}
+	})
+
+	t.Run("round resumes once everyone picked", func(t *testing.T) {
+		game, _ := createGoldTestGame(t)
+		game.RollDice("1")
+		bankBefore := game.BankResources()
+
+		err := game.PickGoldResources("2", coreT.Resources{Ore: 1, Grain: 1})
+		if err != nil {
+			t.Fatalf("expected to pick gold resources just fine, but actually got error %s", err.Error())
+		}
+		if game.RoundType() != round.GoldPick {
+			t.Errorf("expected round to stay GoldPick while player 1 hasn't picked, actually got %s", round.RoundTypeTranslation[game.RoundType()])
+		}
+
+		err = game.PickGoldResources("1", coreT.Resources{Brick: 1})
+		if err != nil {
+			t.Fatalf("expected to pick gold resources just fine, but actually got error %s", err.Error())
+		}
+		if game.RoundType() != round.Regular {
+			t.Errorf("expected round to be Regular after every pick, actually got %s", round.RoundTypeTranslation[game.RoundType()])
+		}
+
+		if game.ResourceHandByPlayer("2").Ore != 1 || game.ResourceHandByPlayer("2").Grain != 1 {
+			t.Errorf("expected player 2 to have the picked resources, actually got %v", game.ResourceHandByPlayer("2"))
+		}
+		bankAfter := game.BankResources()
+		if bankBefore.Total()-bankAfter.Total() != 3 {
+			t.Errorf("expected bank to hand out 3 resources, actually handed out %d", bankBefore.Total()-bankAfter.Total())
+		}
+		fromGold := game.bookKeeping.GetResourcesFromGoldByPlayer()
+		if fromGold["1"].Brick != 1 || fromGold["2"].Total() != 2 {
+			t.Errorf("expected book keeping to record the gold picks, actually got %v", fromGold)
+		}
+	})
+}
+
+func TestGoldFieldBlocked(t *testing.T) {
+	game, tile := createGoldTestGame(t)
+	MockWithBlockedTile(tile.ID)(game)
+	game.RollDice("1")
+
+	if game.RoundType() != round.Regular {
+		t.Errorf("expected a blocked gold field to produce nothing, but round type is %s", round.RoundTypeTranslation[game.RoundType()])
+	}
+	if game.GoldPickAmountByPlayer("1") != 0 || game.GoldPickAmountByPlayer("2") != 0 {
+		t.Errorf("expected nobody to pick gold resources")
+	}
+}
@@ -12,8 +12,13 @@ func (state *GameState) IsPlayerTurn(playerID string) bool {
 
 func (state *GameState) IsPassTurnAllowed(playerID string) bool {
 	isPlayerRound := state.IsPlayerTurn(playerID)
+	return isPlayerRound && state.isBuildingRound()
+}
+
+// Building and buying are allowed during regular rounds and special building windows
+func (state *GameState) isBuildingRound() bool {
 	roundType := state.round.GetRoundType()
-	return isPlayerRound && roundType == round.Regular
+	return roundType == round.Regular || roundType == round.SpecialBuild
 }
 
 func (state *GameState) IsPickingRobbedAllowed(playerID string) bool {
@@ -43,8 +48,7 @@ func (state *GameState) IsStartTradeAllowed(playerID string) bool {
 // FIXME: get hand to see if it has the required resources
 func (state *GameState) IsBuyDevCardAllowed(playerID string) bool {
 	isPlayerRound := state.IsPlayerTurn(playerID)
-	roundType := state.round.GetRoundType()
-	return isPlayerRound && roundType == round.Regular
+	return isPlayerRound && state.isBuildingRound()
 }
 
 func (state *GameState) IsDevCardPlayable(playerID string, devCardType string) bool {
@@ -91,6 +95,16 @@ func (state *GameState) GetAllSettlements() map[int]board.Building {
 	return state.board.GetSettlements()
 }
 
+func (state *GameState) GetAllShips() map[int]board.Building {
+	return state.board.GetShips()
+}
+
+// GetBoard lists the tiles as players see them: hidden tiles have neither resource nor token.
+// Explored tiles are revealed to everyone, so every player gets the same view
 func (state *GameState) GetBoard() []coreT.MapBlock {
-	return state.board.GetTiles()
+	return state.board.GetVisibleTiles()
+}
+
+func (state *GameState) GetSeaTiles() []coreT.MapBlock {
+	return state.board.GetSeaTiles()
 }
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func withLayout(layout coreMaps.Layout) seededTestOption {
+	return withParams(func(params *Params) { params.Layout = int(layout) })
+}
+
+func TestFixedLayout(t *testing.T) {
+	game1 := createSeededTestGame(t, 1, withLayout(coreMaps.LayoutFixed))
+	game2 := createSeededTestGame(t, 2, withLayout(coreMaps.LayoutFixed))
+	if !reflect.DeepEqual(game1.GetBoard(), game2.GetBoard()) {
+		t.Errorf("expected fixed layout to be the same regardless of the seed")
+	}
+	if !reflect.DeepEqual(game1.Ports(), game2.Ports()) {
+		t.Errorf("expected fixed layout ports to be the same regardless of the seed")
+	}
+
+	definitions, _ := coreMaps.GetMapDefinitions("base4")
+	for i, tile := range game1.GetBoard() {
+		expected := definitions.FixedLayout.Tiles[i]
+		if tile.Resource != expected.Resource || tile.Token != expected.Token {
+			t.Errorf("expected tile#%d to be %s %d, actually got %s %d", tile.ID, expected.Resource, expected.Token, tile.Resource, tile.Token)
+		}
+		if tile.Blocked != (tile.Resource == "Desert") {
+			t.Errorf("expected robber to start at the desert, but tile#%d blocked is %v", tile.ID, tile.Blocked)
+		}
+	}
+}
+
+func TestSpiralLayout(t *testing.T) {
+	definitions, _ := coreMaps.GetMapDefinitions("base4")
+
+	t.Run("spiral order - covers every tile once starting from a corner", func(t *testing.T) {
+		for corner := 0; corner < 6; corner++ {
+			order := coreMaps.SpiralOrder(definitions, corner)
+			if len(order) != len(definitions.Tiles) {
+				t.Fatalf("expected spiral to have %d tiles, actually got %d", len(definitions.Tiles), len(order))
+			}
+			seen := make(map[int]bool)
+			for _, tileID := range order {
+				if seen[tileID] {
+					t.Errorf("expected tile#%d to appear once in spiral from corner %d", tileID, corner)
+				}
+				seen[tileID] = true
+			}
+			if order[len(order)-1] != 10 {
+				t.Errorf("expected spiral from corner %d to end at the center, actually ended at tile#%d", corner, order[len(order)-1])
+			}
+		}
+	})
+
+	t.Run("spiral layout - tokens follow the spiral skipping the desert", func(t *testing.T) {
+		for seed := int64(1); seed <= 10; seed++ {
+			game := createSeededTestGame(t, seed, withLayout(coreMaps.LayoutSpiral))
+			tilesByID := make(map[int]coreT.MapBlock)
+			for _, tile := range game.GetBoard() {
+				tilesByID[tile.ID] = tile
+			}
+
+			matched := false
+			for corner := 0; corner < 6 && !matched; corner++ {
+				tokens := make([]int, 0)
+				for _, tileID := range coreMaps.SpiralOrder(definitions, corner) {
+					if tilesByID[tileID].Resource != "Desert" {
+						tokens = append(tokens, tilesByID[tileID].Token)
+					}
+				}
+				matched = reflect.DeepEqual(tokens, definitions.SpiralTokens)
+			}
+			if !matched {
+				t.Errorf("expected tokens of seed %d to follow the spiral from some corner", seed)
+			}
+		}
+	})
+
+	t.Run("spiral layout - deterministic", func(t *testing.T) {
+		game1 := createSeededTestGame(t, 42, withLayout(coreMaps.LayoutSpiral))
+		game2 := createSeededTestGame(t, 42, withLayout(coreMaps.LayoutSpiral))
+		if !reflect.DeepEqual(game1.GetBoard(), game2.GetBoard()) {
+			t.Errorf("expected same seed to generate the same board")
+		}
+	})
+}
@@ -0,0 +1,372 @@
+package core
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	"github.com/victoroliveirab/settlers/core/packages/trade"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// LegalAction is a command the corresponding mutating method accepts in the current state.
+// Open ended actions (creating trade offers and counter offers, forcing trades) are not listed.
+type LegalAction struct {
+	Command
+	// Only set for CommandDiscardPlayerCards: any combination of cards in hand adding up to it is accepted
+	DiscardAmount int `json:"discardAmount,omitempty"`
+	// Only set for CommandPickGoldResources: any combination of cards in the bank adding up to it is accepted
+	GoldPickAmount int `json:"goldPickAmount,omitempty"`
+}
+
+func (state *GameState) LegalActions(playerID string) []LegalAction {
+	actions := make([]LegalAction, 0)
+	playerState, exists := state.playersStates[playerID]
+	if !exists {
+		return actions
+	}
+
+	add := func(command Command) {
+		command.Round = state.round.GetRoundNumber()
+		command.PlayerID = playerID
+		actions = append(actions, LegalAction{Command: command})
+	}
+
+	roundType := state.round.GetRoundType()
+
+	if roundType == round.DiscardPhase {
+		discardAmount := playerState.GetDiscardAmount()
+		if discardAmount > 0 && !playerState.GetHasDiscardedThisTurn() {
+			actions = append(actions, LegalAction{
+				Command: Command{
+					Type:     CommandDiscardPlayerCards,
+					Round:    state.round.GetRoundNumber(),
+					PlayerID: playerID,
+				},
+				DiscardAmount: discardAmount,
+			})
+		}
+		return actions
+	}
+
+	if roundType == round.GoldPick {
+		if goldPickAmount := state.GoldPickAmountByPlayer(playerID); goldPickAmount > 0 {
+			actions = append(actions, LegalAction{
+				Command: Command{
+					Type:     CommandPickGoldResources,
+					Round:    state.round.GetRoundNumber(),
+					PlayerID: playerID,
+				},
+				GoldPickAmount: goldPickAmount,
+			})
+		}
+		return actions
+	}
+
+	for _, command := range state.legalTradeResponses(playerID) {
+		add(command)
+	}
+
+	if !state.IsPlayerTurn(playerID) {
+		return actions
+	}
+
+	resources := playerState.GetResources()
+
+	switch roundType {
+	case round.SetupSettlement1, round.SetupSettlement2:
+		vertices, _ := state.AvailableVertices(playerID)
+		slices.Sort(vertices)
+		for _, vertexID := range vertices {
+			add(Command{Type: CommandBuildSettlement, VertexID: vertexID})
+		}
+	case round.SetupRoad1, round.SetupRoad2:
+		roads := state.board.GetRoads()
+		edges, _ := state.AvailableEdges(playerID)
+		edges = slices.Clone(edges)
+		slices.Sort(edges)
+		for _, edgeID := range edges {
+			if _, exists := roads[edgeID]; !exists {
+				add(Command{Type: CommandBuildRoad, EdgeID: edgeID})
+			}
+		}
+	case round.FirstRound, round.BetweenTurns:
+		dice := state.round.GetDice()
+		if dice[0] == 0 && dice[1] == 0 {
+			add(Command{Type: CommandRollDice})
+		}
+		if roundType == round.BetweenTurns && state.canMoveRobberToDesert(playerID) {
+			add(Command{Type: CommandMoveRobberToDesert})
+		}
+	case round.Regular, round.SpecialBuild:
+		add(Command{Type: CommandEndRound})
+		if resources.Covers(coreT.SettlementCost) && len(playerState.GetSettlements()) < state.maxSettlements {
+			for _, vertexID := range state.legalSettlementVertices(playerID) {
+				add(Command{Type: CommandBuildSettlement, VertexID: vertexID})
+			}
+		}
+		if playerState.HasResourcesToBuildCity() && len(playerState.GetCities()) < state.maxCities {
+			vertices := slices.Clone(playerState.GetSettlements())
+			slices.Sort(vertices)
+			for _, vertexID := range vertices {
+				add(Command{Type: CommandBuildCity, VertexID: vertexID})
+			}
+		}
+		if resources.Covers(coreT.RoadCost) && len(playerState.GetRoads()) < state.maxRoads {
+			for _, edgeID := range state.legalRoadEdges(playerID) {
+				add(Command{Type: CommandBuildRoad, EdgeID: edgeID})
+			}
+		}
+		if resources.Covers(coreT.ShipCost) && playerState.GetNumberOfShips() < state.maxShips {
+			for _, edgeID := range state.legalShipEdges(playerID) {
+				add(Command{Type: CommandBuildShip, EdgeID: edgeID})
+			}
+		}
+		if resources.Covers(coreT.DevelopmentCardCost) && !state.development.IsEmpty() {
+			add(Command{Type: CommandBuyDevelopmentCard})
+		}
+		if roundType == round.SpecialBuild {
+			break
+		}
+		for _, command := range state.legalShipMoves(playerID) {
+			add(command)
+		}
+		for _, command := range state.legalBankAndPortTrades(playerID) {
+			add(command)
+		}
+		if state.canMoveRobberToDesert(playerID) {
+			add(Command{Type: CommandMoveRobberToDesert})
+		}
+	case round.MoveRobberDue7, round.MoveRobberDueKnight:
+		for _, tileID := range state.RobberDestinations() {
+			add(Command{Type: CommandMoveRobber, TileID: tileID})
+		}
+	case round.PickRobbed:
+		robbablePlayers, _ := state.RobbablePlayers(playerID)
+		slices.Sort(robbablePlayers)
+		for _, robbedID := range robbablePlayers {
+			add(Command{Type: CommandRobPlayer, TargetPlayerID: robbedID})
+		}
+	case round.BuildRoad1Development, round.BuildRoad2Development:
+		if len(playerState.GetRoads()) < state.maxRoads {
+			for _, edgeID := range state.legalRoadEdges(playerID) {
+				add(Command{Type: CommandPickRoadBuildingSpot, EdgeID: edgeID})
+			}
+		}
+	case round.MonopolyPickResource:
+		for _, resource := range ResourcesOrder {
+			add(Command{Type: CommandPickMonopolyResource, Resource: resource})
+		}
+	case round.YearOfPlentyPickResources:
+		for i, resource1 := range ResourcesOrder {
+			for _, resource2 := range ResourcesOrder[i:] {
+				requested := coreT.ResourcesOf(resource1, 1).Add(coreT.ResourcesOf(resource2, 1))
+				if !state.bank.Has(requested) {
+					continue
+				}
+				add(Command{Type: CommandPickYearOfPlentyResources, Resource: resource1, SecondResource: resource2})
+			}
+		}
+	}
+
+	for _, devCardType := range []string{"Knight", "Monopoly", "Road Building", "Year of Plenty"} {
+		if state.isDevelopmentCardUsable(playerID, devCardType) {
+			add(Command{Type: CommandUseDevelopmentCard, DevelopmentCard: devCardType})
+		}
+	}
+
+	return actions
+}
+
+// Mirrors the checks made by BuildSettlement during regular rounds
+func (state *GameState) legalSettlementVertices(playerID string) []int {
+	settlements := state.board.GetSettlements()
+	cities := state.board.GetCities()
+	vertices := make([]int, 0)
+	for vertexID := range state.board.Definition.TilesByVertex {
+		_, settlementExists := settlements[vertexID]
+		_, cityExists := cities[vertexID]
+		if settlementExists || cityExists {
+			continue
+		}
+		if state.hasBuildingAtSameEdge(vertexID) > 0 {
+			continue
+		}
+		if !state.ownsRoadApproaching(playerID, vertexID) && !state.ownsShipApproaching(playerID, vertexID) {
+			continue
+		}
+		vertices = append(vertices, vertexID)
+	}
+	slices.Sort(vertices)
+	return vertices
+}
+
+// Mirrors the checks made by BuildRoad and PickRoadBuildingSpot outside of the setup phase
+func (state *GameState) legalRoadEdges(playerID string) []int {
+	roads := state.board.GetRoads()
+	ships := state.board.GetShips()
+	edges := make([]int, 0)
+	for edgeID := range state.board.Definition.VerticesByEdge {
+		if _, exists := roads[edgeID]; exists {
+			continue
+		}
+		if _, exists := ships[edgeID]; exists || state.isSeaEdge(edgeID) {
+			continue
+		}
+		if !state.ownsBuildingApproaching(playerID, edgeID) {
+			continue
+		}
+		edges = append(edges, edgeID)
+	}
+	slices.Sort(edges)
+	return edges
+}
+
+// Mirrors the checks made by BuildShip
+func (state *GameState) legalShipEdges(playerID string) []int {
+	roads := state.board.GetRoads()
+	ships := state.board.GetShips()
+	edges := make([]int, 0)
+	for edgeID := range state.board.Definition.VerticesByEdge {
+		_, hasRoad := roads[edgeID]
+		_, hasShip := ships[edgeID]
+		if hasRoad || hasShip || !state.isShipEdge(edgeID) {
+			continue
+		}
+		if !state.ownsShippingApproaching(playerID, edgeID) {
+			continue
+		}
+		edges = append(edges, edgeID)
+	}
+	slices.Sort(edges)
+	return edges
+}
+
+// Mirrors the checks made by MoveShip
+func (state *GameState) legalShipMoves(playerID string) []Command {
+	commands := make([]Command, 0)
+	roads := state.board.GetRoads()
+	ships := state.board.GetShips()
+	for _, fromEdgeID := range state.MovableShips(playerID) {
+		for _, toEdgeID := range slices.Sorted(maps.Keys(state.board.Definition.VerticesByEdge)) {
+			_, hasRoad := roads[toEdgeID]
+			_, hasShip := ships[toEdgeID]
+			if hasRoad || hasShip || !state.isShipEdge(toEdgeID) {
+				continue
+			}
+			if state.ownsShippingApproachingWithout(playerID, toEdgeID, fromEdgeID) {
+				commands = append(commands, Command{Type: CommandMoveShip, EdgeID: fromEdgeID, SecondEdgeID: toEdgeID})
+			}
+		}
+	}
+	return commands
+}
+
+// Lists every affordable one-for-one trade with the bank and owned ports
+func (state *GameState) legalBankAndPortTrades(playerID string) []Command {
+	commands := make([]Command, 0)
+	playerState := state.playersStates[playerID]
+	resources := playerState.GetResources()
+	ownedPorts := playerState.GetPortTypes()
+	ownsGeneralPort := utils.SliceContains(ownedPorts, "General")
+
+	for _, given := range ResourcesOrder {
+		rates := make(map[CommandType]int)
+		if !ownsGeneralPort {
+			rates[CommandMakeBankTrade] = state.bankTradeAmount
+		}
+		if utils.SliceContains(ownedPorts, string(given)) {
+			rates[CommandMakeResourcePortTrade] = state.resourcePortCost
+		} else if ownsGeneralPort {
+			rates[CommandMakeGeneralPortTrade] = state.generalPortCost
+		}
+		for _, commandType := range []CommandType{CommandMakeBankTrade, CommandMakeGeneralPortTrade, CommandMakeResourcePortTrade} {
+			cost, exists := rates[commandType]
+			if !exists || cost <= 0 || resources.Get(given) < cost {
+				continue
+			}
+			for _, requested := range ResourcesOrder {
+				if requested == given || state.bank.Available(requested) == 0 {
+					continue
+				}
+				commands = append(commands, Command{
+					Type:      commandType,
+					Given:     coreT.ResourcesOf(given, cost),
+					Requested: coreT.ResourcesOf(requested, 1),
+				})
+			}
+		}
+	}
+	return commands
+}
+
+// Lists accept, reject, cancel and finalize of the currently open trades
+func (state *GameState) legalTradeResponses(playerID string) []Command {
+	commands := make([]Command, 0)
+	resources := state.playersStates[playerID].GetResources()
+
+	for _, t := range state.trade.ActiveTrades() {
+		if t.Creator == playerID {
+			commands = append(commands, Command{Type: CommandCancelTradeOffer, TradeID: t.ID})
+		}
+
+		response := t.Responses[playerID]
+		if response != nil && !response.Blocked {
+			if resources.Covers(t.Request) {
+				commands = append(commands, Command{Type: CommandAcceptTradeOffer, TradeID: t.ID})
+			}
+			if t.Requester != playerID {
+				commands = append(commands, Command{Type: CommandRejectTradeOffer, TradeID: t.ID})
+			}
+		}
+		if t.Requester == playerID && state.trade.GetTrade(t.ParentID) != nil && (response == nil || !response.Blocked) {
+			commands = append(commands, Command{Type: CommandRejectTradeOffer, TradeID: t.ID})
+		}
+
+		if t.Requester != playerID || !state.IsPlayerTurn(playerID) || !resources.Covers(t.Offer) {
+			continue
+		}
+		accepters := make([]string, 0)
+		for accepterID, accepterResponse := range t.Responses {
+			if accepterID == playerID || accepterResponse.Status != trade.Accepted {
+				continue
+			}
+			if state.playersStates[accepterID].GetResources().Covers(t.Request) {
+				accepters = append(accepters, accepterID)
+			}
+		}
+		slices.Sort(accepters)
+		for _, accepterID := range accepters {
+			commands = append(commands, Command{Type: CommandFinalizeTrade, TradeID: t.ID, TargetPlayerID: accepterID})
+		}
+	}
+	return commands
+}
+
+// Mirrors the checks made by consumeDevelopmentCardByPlayer and the UseX methods
+func (state *GameState) isDevelopmentCardUsable(playerID, devCardType string) bool {
+	roundType := state.round.GetRoundType()
+	switch devCardType {
+	case "Knight":
+		if roundType != round.FirstRound && roundType != round.Regular && roundType != round.BetweenTurns {
+			return false
+		}
+	default:
+		if roundType != round.Regular {
+			return false
+		}
+	}
+
+	playerState := state.playersStates[playerID]
+	if devCardType == "Road Building" && len(playerState.GetRoads()) >= state.maxRoads {
+		return false
+	}
+	if devCardType == "Year of Plenty" && !state.bankHasTwoResources() {
+		return false
+	}
+	if playerState.GetNumberOfDevCardsPlayedCurrentTurn() >= state.maxDevCardsPerRound {
+		return false
+	}
+	return state.IsDevCardPlayable(playerID, devCardType)
+}
@@ -0,0 +1,234 @@
+package core
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// concreteCommand fills the open parts of a legal action so it can be applied
+func concreteCommand(game *GameState, action LegalAction) Command {
+	command := action.Command
+	switch command.Type {
+	case CommandDiscardPlayerCards:
+		command.Resources = firstResources(game.ResourceHandByPlayer(command.PlayerID), action.DiscardAmount)
+	case CommandPickGoldResources:
+		command.Resources = firstResources(game.bank.GetResources(), action.GoldPickAmount)
+	}
+	return command
+}
+
+// firstResources takes amount cards out of available, in ResourcesOrder
+func firstResources(available coreT.Resources, amount int) coreT.Resources {
+	resources := coreT.Resources{}
+	for _, resource := range ResourcesOrder {
+		quantity := min(available.Get(resource), amount)
+		resources = resources.Add(coreT.ResourcesOf(resource, quantity))
+		amount -= quantity
+	}
+	return resources
+}
+
+type legalActionKey struct {
+	commandType     CommandType
+	playerID        string
+	vertexID        int
+	edgeID          int
+	tileID          int
+	targetPlayerID  string
+	resource        coreT.Resource
+	secondResource  coreT.Resource
+	developmentCard string
+}
+
+// keyOf identifies a command by the arguments candidateCommands varies, so the ways of using a development card,
+// both orders of Year of Plenty resources and building a road while picking road building spots compare equal
+func keyOf(command Command, roundType round.Type) legalActionKey {
+	key := legalActionKey{
+		commandType:     command.Type,
+		playerID:        command.PlayerID,
+		vertexID:        command.VertexID,
+		edgeID:          command.EdgeID,
+		tileID:          command.TileID,
+		targetPlayerID:  command.TargetPlayerID,
+		resource:        command.Resource,
+		secondResource:  command.SecondResource,
+		developmentCard: command.DevelopmentCard,
+	}
+	useCommands := map[CommandType]string{
+		CommandUseKnight:       "Knight",
+		CommandUseMonopoly:     "Monopoly",
+		CommandUseRoadBuilding: "Road Building",
+		CommandUseYearOfPlenty: "Year of Plenty",
+	}
+	if devCardType, ok := useCommands[key.commandType]; ok {
+		key.commandType = CommandUseDevelopmentCard
+		key.developmentCard = devCardType
+	}
+	if key.commandType == CommandBuildRoad && (roundType == round.BuildRoad1Development || roundType == round.BuildRoad2Development) {
+		key.commandType = CommandPickRoadBuildingSpot
+	}
+	if key.commandType == CommandPickYearOfPlentyResources && slices.Index(ResourcesOrder[:], key.resource) > slices.Index(ResourcesOrder[:], key.secondResource) {
+		key.resource, key.secondResource = key.secondResource, key.resource
+	}
+	return key
+}
+
+// candidateCommands lists every command of playerID with closed arguments, legal or not.
+// Trades, discards and gold picks are left out since their arguments are open ended
+func candidateCommands(game *GameState, playerID string) []Command {
+	commands := make([]Command, 0)
+	for _, commandType := range []CommandType{
+		CommandRollDice, CommandEndRound, CommandBuyDevelopmentCard, CommandUseKnight, CommandUseMonopoly,
+		CommandUseRoadBuilding, CommandUseYearOfPlenty, CommandMoveRobberToDesert,
+	} {
+		commands = append(commands, Command{Type: commandType})
+	}
+	for _, devCardType := range []string{"Knight", "Monopoly", "Road Building", "Year of Plenty"} {
+		commands = append(commands, Command{Type: CommandUseDevelopmentCard, DevelopmentCard: devCardType})
+	}
+	for _, vertexID := range slices.Sorted(maps.Keys(game.board.Definition.EdgesByVertex)) {
+		commands = append(commands, Command{Type: CommandBuildSettlement, VertexID: vertexID})
+		commands = append(commands, Command{Type: CommandBuildCity, VertexID: vertexID})
+	}
+	for _, edgeID := range slices.Sorted(maps.Keys(game.board.Definition.VerticesByEdge)) {
+		commands = append(commands, Command{Type: CommandBuildRoad, EdgeID: edgeID})
+		commands = append(commands, Command{Type: CommandBuildShip, EdgeID: edgeID})
+		commands = append(commands, Command{Type: CommandPickRoadBuildingSpot, EdgeID: edgeID})
+	}
+	for _, tile := range append(game.board.GetTiles(), game.board.GetSeaTiles()...) {
+		commands = append(commands, Command{Type: CommandMoveRobber, TileID: tile.ID})
+	}
+	for _, player := range game.Players() {
+		commands = append(commands, Command{Type: CommandRobPlayer, TargetPlayerID: player.ID})
+	}
+	for i, resource := range ResourcesOrder {
+		commands = append(commands, Command{Type: CommandPickMonopolyResource, Resource: resource})
+		for _, secondResource := range ResourcesOrder[i:] {
+			commands = append(commands, Command{Type: CommandPickYearOfPlentyResources, Resource: resource, SecondResource: secondResource})
+		}
+	}
+	for i := range commands {
+		commands[i].Round = game.round.GetRoundNumber()
+		commands[i].PlayerID = playerID
+	}
+	return commands
+}
+
+func TestLegalActionsAgreeWithMutators(t *testing.T) {
+	variants := map[string][]seededTestOption{
+		"base4":            {},
+		"base4 two player": {twoPlayerVariant},
+		"base6":            {onMap("base6", 6), withParams(func(params *Params) { params.SpecialBuildPhase = 1 })},
+		"islands4":         {islands4},
+	}
+	for name, options := range variants {
+		t.Run(name, func(t *testing.T) {
+			for _, seed := range []int64{1, 42, 1337} {
+				game := createSeededTestGame(t, seed, options...)
+				picker := rand.New(rand.NewSource(seed))
+
+				for step := 0; step < 300 && game.RoundType() != round.GameOver; step++ {
+					allActions := make([]LegalAction, 0)
+					legal := make(map[legalActionKey]bool)
+					for _, player := range game.Players() {
+						for _, action := range game.LegalActions(player.ID) {
+							copy := game.Clone()
+							err := copy.ApplyCommand(concreteCommand(copy, action))
+							if err != nil {
+								t.Fatalf("seed %d, step %d: expected legal action %+v to be accepted, but actually got error %s", seed, step, action.Command, err.Error())
+							}
+							allActions = append(allActions, action)
+							legal[keyOf(action.Command, game.RoundType())] = true
+						}
+					}
+
+					// Rejected commands leave the game untouched, so the same copy is used until one is accepted
+					copy := game.Clone()
+					for _, player := range game.Players() {
+						for _, command := range candidateCommands(game, player.ID) {
+							if err := copy.ApplyCommand(command); err != nil {
+								continue
+							}
+							if !legal[keyOf(command, game.RoundType())] {
+								t.Fatalf("seed %d, step %d: expected accepted command %+v to be a legal action during %s", seed, step, command, round.RoundTypeTranslation[game.RoundType()])
+							}
+							copy = game.Clone()
+						}
+					}
+
+					if len(allActions) == 0 {
+						t.Fatalf("seed %d, step %d: expected to have at least one legal action during %s", seed, step, round.RoundTypeTranslation[game.RoundType()])
+					}
+
+					action := allActions[picker.Intn(len(allActions))]
+					err := game.ApplyCommand(concreteCommand(game, action))
+					if err != nil {
+						t.Fatalf("seed %d, step %d: expected picked action %+v to be accepted, but actually got error %s", seed, step, action.Command, err.Error())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLegalActionsOtherPlayerTurn(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	actions := game.LegalActions("2")
+	if len(actions) != 0 {
+		t.Errorf("expected player#2 to have no legal actions during player#1 setup, but actually has %d", len(actions))
+	}
+
+	actions = game.LegalActions("1")
+	for _, action := range actions {
+		if action.Type != CommandBuildSettlement {
+			t.Errorf("expected only %s actions during setup, but found %s", CommandBuildSettlement, action.Type)
+		}
+	}
+}
+
+func TestLegalActionsPlayerTrade(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
+			"1": {Lumber: 1},
+			"2": {Brick: 1},
+			"3": {},
+		}),
+	)
+	tradeID, err := game.MakeTradeOffer("1", coreT.Resources{Lumber: 1}, coreT.Resources{Brick: 1}, []string{})
+	if err != nil {
+		t.Fatalf("expected to create trade offer just fine, but actually got error %s", err.Error())
+	}
+
+	hasAction := func(playerID string, commandType CommandType, targetPlayerID string) bool {
+		for _, action := range game.LegalActions(playerID) {
+			if action.Type == commandType && action.TradeID == tradeID && action.TargetPlayerID == targetPlayerID {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !hasAction("2", CommandAcceptTradeOffer, "") || !hasAction("2", CommandRejectTradeOffer, "") {
+		t.Errorf("expected player#2 to be able to accept and reject trade#%d", tradeID)
+	}
+	if hasAction("3", CommandAcceptTradeOffer, "") {
+		t.Errorf("expected player#3 to not be able to accept trade#%d without the requested resources", tradeID)
+	}
+	if !hasAction("1", CommandCancelTradeOffer, "") || hasAction("1", CommandFinalizeTrade, "2") {
+		t.Errorf("expected player#1 to be able to cancel but not finalize trade#%d", tradeID)
+	}
+
+	err = game.AcceptTradeOffer("2", tradeID)
+	if err != nil {
+		t.Fatalf("expected player#2 to accept trade offer just fine, but actually got error %s", err.Error())
+	}
+	if !hasAction("1", CommandFinalizeTrade, "2") {
+		t.Errorf("expected player#1 to be able to finalize trade#%d with player#2", tradeID)
+	}
+}
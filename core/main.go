@@ -2,12 +2,15 @@ package core
 
 import (
 	"fmt"
+	"maps"
 	"math/rand"
 
 	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/bank"
 	"github.com/victoroliveirab/settlers/core/packages/board"
 	"github.com/victoroliveirab/settlers/core/packages/book-keeping"
 	"github.com/victoroliveirab/settlers/core/packages/development"
+	"github.com/victoroliveirab/settlers/core/packages/dice"
 	"github.com/victoroliveirab/settlers/core/packages/player"
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	"github.com/victoroliveirab/settlers/core/packages/summary"
@@ -32,16 +35,27 @@ type MostKnights struct {
 }
 
 type GameState struct {
+	bank                *bank.Instance
 	board               *board.Instance
 	bookKeeping         *bookkeeping.Instance
 	rand                *rand.Rand
+	randSource          *utils.RandSource
 	logs                []StateLog
 	maxCards            int
 	maxSettlements      int
 	maxCities           int
 	maxRoads            int
+	maxShips            int
 	maxDevCardsPerRound int
 	bankTradeAmount     int
+	specialBuildPhase   bool
+	twoPlayer           bool
+	fogOfWar            bool
+	explorationReward   bool
+
+	// robber related
+	friendlyRobber         int
+	robberProtectionRounds int
 
 	// player
 	players       []coreT.Player
@@ -50,6 +64,9 @@ type GameState struct {
 	// trade
 	trade *trade.Instance
 
+	// two-player variant related
+	tradeTokens map[string]int
+
 	// cost related
 	generalPortCost  int
 	resourcePortCost int
@@ -69,22 +86,36 @@ type GameState struct {
 	// round related
 	round              *round.Instance
 	currentPlayerIndex int
+	dice               dice.Source
 
 	// cards related
 	development *development.Instance
 
 	// post-game related
 	summary *summary.Instance
+
+	// replay related
+	actionLog    []Command
+	commandDepth int
+
+	// events related
+	subscriptions []*subscription
+	pendingEvents []Event
+
+	// validates the state after every mutating method
+	strict bool
 }
 
 type Params struct {
-	Speed                int
-	BankTradeAmount      int
-	MaxCards             int
-	MaxDevCardsPerRound  int
-	MaxSettlements       int
-	MaxCities            int
-	MaxRoads             int
+	Speed               int
+	BankTradeAmount     int
+	MaxCards            int
+	MaxDevCardsPerRound int
+	MaxSettlements      int
+	MaxCities           int
+	MaxRoads            int
+	// Only maps with sea tiles have room for ships
+	MaxShips             int
 	TargetPoint          int
 	PointsPerSettlement  int
 	PointsPerCity        int
@@ -92,17 +123,124 @@ type Params struct {
 	PointsForLongestRoad int
 	MostKnightsMinimum   int
 	LongestRoadMinimum   int
+	// Cards of each resource in the bank at the start of the game. Defaults to 19
+	BankSupply int
+	// 1 to generate a board following maps.DefaultBalanceConstraints instead of a purely random one
+	BalancedBoard int
+	// 0 for a random board, 1 for the official spiral token placement and 2 for the map fixed layout
+	Layout int
+	// 1 to let every other player build after each turn, as in 5-6 player games
+	SpecialBuildPhase int
+	// 1 for the two-player variant, with neutral players, trade tokens and two dice rolls per turn
+	TwoPlayer int
+	// 0 for a revealed board, 1 to hide the tiles outside the map starting region until explored
+	// and 2 to also hand the explorer a card of each tile they reveal
+	FogOfWar int
+	// 0 for the regular robber, N to forbid placing it on tiles touching players with N or fewer public points
+	FriendlyRobber int
+	// Number of rounds at the start of the game in which the robber robs nobody and 7s make nobody discard
+	RobberProtectionRounds int
+	// 0 for classic dice, 1 for the event deck and 2 for balanced dice
+	Dice int
+	// Number of cards left in the event deck when it's reshuffled
+	EventDeckReshuffle int
+	// Code of a board to play again, taking precedence over BalancedBoard and Layout
+	BoardCode string
+}
+
+type paramField struct {
+	key   string
+	value *int
+}
+
+// fields pairs each numeric param with its key in map metadata and room params
+func (params *Params) fields() []paramField {
+	return []paramField{
+		{"speed", &params.Speed},
+		{"bankTradeAmount", &params.BankTradeAmount},
+		{"maxCards", &params.MaxCards},
+		{"maxDevCardsPerRound", &params.MaxDevCardsPerRound},
+		{"maxSettlements", &params.MaxSettlements},
+		{"maxCities", &params.MaxCities},
+		{"maxRoads", &params.MaxRoads},
+		{"maxShips", &params.MaxShips},
+		{"targetPoint", &params.TargetPoint},
+		{"pointsPerSettlement", &params.PointsPerSettlement},
+		{"pointsPerCity", &params.PointsPerCity},
+		{"pointsForMostKnights", &params.PointsForMostKnights},
+		{"pointsForLongestRoad", &params.PointsForLongestRoad},
+		{"mostKnightsMinimum", &params.MostKnightsMinimum},
+		{"longestRoadMinimum", &params.LongestRoadMinimum},
+		{"bankSupply", &params.BankSupply},
+		{"balancedBoard", &params.BalancedBoard},
+		{"layout", &params.Layout},
+		{"specialBuildPhase", &params.SpecialBuildPhase},
+		{"twoPlayer", &params.TwoPlayer},
+		{"fogOfWar", &params.FogOfWar},
+		{"friendlyRobber", &params.FriendlyRobber},
+		{"robberProtectionRounds", &params.RobberProtectionRounds},
+		{"dice", &params.Dice},
+		{"eventDeckReshuffle", &params.EventDeckReshuffle},
+	}
+}
+
+// ParamsKeys lists the keys ParamsFromMap understands
+func ParamsKeys() []string {
+	fields := (&Params{}).fields()
+	keys := make([]string, len(fields))
+	for i, field := range fields {
+		keys[i] = field.key
+	}
+	return keys
+}
+
+// ParamsFromMap builds Params out of values keyed as in map metadata and room params. Unknown keys are ignored
+func ParamsFromMap(values map[string]int) Params {
+	params := Params{}
+	for _, field := range params.fields() {
+		if value, ok := values[field.key]; ok {
+			*field.value = value
+		}
+	}
+	return params
 }
 
 func (state *GameState) New(players []*coreT.Player, mapName string, randGenerator *rand.Rand, params Params) error {
+	if params.TwoPlayer == 1 && len(players) != 2 {
+		err := fmt.Errorf("Cannot play the two-player variant with %d players", len(players))
+		return err
+	}
+	if _, exists := dice.ModeNames[dice.Mode(params.Dice)]; !exists {
+		err := fmt.Errorf("Cannot roll with unknown dice source %d", params.Dice)
+		return err
+	}
+	if params.EventDeckReshuffle < 0 || params.EventDeckReshuffle >= 36 {
+		err := fmt.Errorf("Cannot reshuffle the event deck with %d cards left", params.EventDeckReshuffle)
+		return err
+	}
+
 	state.rand = randGenerator
 	mapDefinitions, err := coreMaps.GetMapDefinitions(mapName)
 	if err != nil {
 		return err
 	}
 
-	state.board = board.New(mapName, mapDefinitions, randGenerator)
+	state.bank = bank.New(params.BankSupply)
+	var balance *coreMaps.BalanceConstraints
+	if params.BalancedBoard == 1 {
+		balance = &coreMaps.DefaultBalanceConstraints
+	}
+	if params.BoardCode != "" {
+		state.board, err = board.NewFromCode(mapName, mapDefinitions, params.BoardCode)
+	} else {
+		state.board, err = board.New(mapName, mapDefinitions, randGenerator, coreMaps.Layout(params.Layout), balance)
+	}
+	if err != nil {
+		return err
+	}
 	state.bookKeeping = bookkeeping.New(players)
+	state.dice = dice.New(dice.Mode(params.Dice), params.EventDeckReshuffle)
+	state.bookKeeping.SetDiceMode(dice.ModeNames[state.dice.Mode()])
 
 	developmentCards := utils.MapToShuffledSlice[*coreT.DevelopmentCard](
 		mapDefinitions.DevelopmentCards,
@@ -114,12 +252,20 @@ func (state *GameState) New(players []*coreT.Player, mapName string, randGenerat
 	state.playersStates = make(map[string]*player.Instance)
 
 	state.logs = make([]StateLog, 0)
+	state.actionLog = make([]Command, 0)
 	state.maxCards = params.MaxCards
 	state.maxSettlements = params.MaxSettlements
 	state.maxCities = params.MaxCities
 	state.maxRoads = params.MaxRoads
+	state.maxShips = params.MaxShips
 	state.maxDevCardsPerRound = params.MaxDevCardsPerRound
 	state.bankTradeAmount = params.BankTradeAmount
+	state.specialBuildPhase = params.SpecialBuildPhase == 1
+	state.twoPlayer = params.TwoPlayer == 1
+	state.fogOfWar = params.FogOfWar > 0
+	state.explorationReward = params.FogOfWar == 2
+	state.friendlyRobber = params.FriendlyRobber
+	state.robberProtectionRounds = params.RobberProtectionRounds
 	state.pointsPerSettlement = params.PointsPerSettlement
 	state.pointsPerCity = params.PointsPerCity
 	state.pointsPerMostKnights = params.PointsForMostKnights
@@ -141,52 +287,71 @@ func (state *GameState) New(players []*coreT.Player, mapName string, randGenerat
 			ID:    playerDefinition.ID,
 			Color: playerDefinition.Color,
 		}
-		state.playersStates[playerDefinition.ID] = player.New(playerDefinition, map[string]int{
-			"Lumber": 0,
-			"Brick":  0,
-			"Sheep":  0,
-			"Grain":  0,
-			"Ore":    0,
-		}, map[string][]*coreT.DevelopmentCard{})
+		state.playersStates[playerDefinition.ID] = player.New(playerDefinition, coreT.Resources{}, map[string][]*coreT.DevelopmentCard{})
 	}
 	state.summary = summary.New(
 		state.playersStates,
 		state.GetSettings(),
 		state.bookKeeping,
 	)
-	state.trade = trade.New(state.bookKeeping)
+	state.trade = trade.New(state.bookKeeping, state.bank)
 
-	return nil
-}
+	if state.fogOfWar {
+		if err := state.hideUnexploredTiles(); err != nil {
+			return err
+		}
+	}
 
-func (state *GameState) currentPlayer() *coreT.Player {
-	return &state.players[state.currentPlayerIndex]
+	state.tradeTokens = make(map[string]int)
+	if state.twoPlayer {
+		for _, player := range state.players {
+			state.tradeTokens[player.ID] = startingTradeTokens
+		}
+		state.placeNeutralPlayers()
+	}
+
+	return nil
 }
 
-func (state *GameState) findPlayer(playerID string) *coreT.Player {
-	for _, player := range state.players {
-		if player.ID == playerID {
-			return &player
-		}
+// NewWithSeed works like New, but the game owns a random source whose position is tracked,
+// which is required to snapshot the game later on
+func (state *GameState) NewWithSeed(players []*coreT.Player, mapName string, seed int64, params Params) error {
+	source := utils.NewRandSource(seed)
+	err := state.New(players, mapName, rand.New(source), params)
+	if err != nil {
+		return err
 	}
+	state.randSource = source
 	return nil
 }
 
+func (state *GameState) currentPlayer() *coreT.Player {
+	return &state.players[state.currentPlayerIndex]
+}
+
 func (state *GameState) GetSettings() coreT.Settings {
 	return coreT.Settings{
-		BankTradeAmount:      state.bankTradeAmount,
-		MaxCards:             state.maxCards,
-		MaxDevCardsPerRound:  state.maxDevCardsPerRound,
-		MaxSettlements:       state.maxSettlements,
-		MaxCities:            state.maxCities,
-		MaxRoads:             state.maxRoads,
-		TargetPoint:          state.targetPoint,
-		PointsPerSettlement:  state.pointsPerSettlement,
-		PointsPerCity:        state.pointsPerCity,
-		PointsForMostKnights: state.pointsPerMostKnights,
-		PointsForLongestRoad: state.pointsPerLongestRoad,
-		MostKnightsMinimum:   state.mostKnightsMinimum,
-		LongestRoadMinimum:   state.longestRoadMinimum,
+		BankTradeAmount:        state.bankTradeAmount,
+		MaxCards:               state.maxCards,
+		MaxDevCardsPerRound:    state.maxDevCardsPerRound,
+		MaxSettlements:         state.maxSettlements,
+		MaxCities:              state.maxCities,
+		MaxRoads:               state.maxRoads,
+		MaxShips:               state.maxShips,
+		TargetPoint:            state.targetPoint,
+		PointsPerSettlement:    state.pointsPerSettlement,
+		PointsPerCity:          state.pointsPerCity,
+		PointsForMostKnights:   state.pointsPerMostKnights,
+		PointsForLongestRoad:   state.pointsPerLongestRoad,
+		MostKnightsMinimum:     state.mostKnightsMinimum,
+		LongestRoadMinimum:     state.longestRoadMinimum,
+		BankSupply:             state.bank.GetSupply(),
+		SpecialBuildPhase:      state.specialBuildPhase,
+		TwoPlayer:              state.twoPlayer,
+		FogOfWar:               state.fogOfWar,
+		ExplorationReward:      state.explorationReward,
+		FriendlyRobber:         state.friendlyRobber,
+		RobberProtectionRounds: state.robberProtectionRounds,
 	}
 }
 
@@ -196,6 +361,16 @@ func (state *GameState) MapName() string {
 	return state.board.MapName
 }
 
+// BoardCode is the code to play this board again in a new game, empty for boards set up by hand
+func (state *GameState) BoardCode() string {
+	return state.board.GetCode()
+}
+
+// BoardBalance measures how fair the board is
+func (state *GameState) BoardBalance() coreMaps.BalanceReport {
+	return coreMaps.Balance(state.board.Definition, state.board.GetTiles())
+}
+
 func (state *GameState) PortsByVertex() map[int]string {
 	return state.board.Ports
 }
@@ -215,6 +390,18 @@ func (state *GameState) Ports() []coreT.Port {
 	return ports
 }
 
+func (state *GameState) TilesByVertex(vertexID int) []int {
+	return state.board.Definition.TilesByVertex[vertexID]
+}
+
+func (state *GameState) EdgesByVertex(vertexID int) []int {
+	return state.board.Definition.EdgesByVertex[vertexID]
+}
+
+func (state *GameState) VerticesByEdge(edgeID int) [2]int {
+	return state.board.Definition.VerticesByEdge[edgeID]
+}
+
 func (state *GameState) PortsByPlayer(playerID string) []string {
 	return state.playersStates[playerID].GetPortTypes()
 }
@@ -236,6 +423,11 @@ func (state *GameState) Dice() [2]int {
 	return state.round.GetDice()
 }
 
+// SecondDice is the second roll of the turn in the two-player variant, zeroed otherwise
+func (state *GameState) SecondDice() [2]int {
+	return state.round.GetSecondDice()
+}
+
 func (state *GameState) RoundType() round.Type {
 	return state.round.GetRoundType()
 }
@@ -249,18 +441,13 @@ func (state *GameState) DevelopmentHandByPlayer(playerID string) map[string]int
 	return devHand
 }
 
-func (state *GameState) ResourceHandByPlayer(playerID string) map[string]int {
+func (state *GameState) ResourceHandByPlayer(playerID string) coreT.Resources {
 	playerState := state.playersStates[playerID]
 	return playerState.GetResources()
 }
 
 func (state *GameState) NumberOfCardsInHandByPlayer(playerID string) int {
-	hand := state.ResourceHandByPlayer(playerID)
-	sum := 0
-	for _, count := range hand {
-		sum += count
-	}
-	return sum
+	return state.ResourceHandByPlayer(playerID).Total()
 }
 
 func (state *GameState) SettlementsByPlayer(playerID string) []int {
@@ -278,6 +465,11 @@ func (state *GameState) RoadsByPlayer(playerID string) []int {
 	return playerState.GetRoads()
 }
 
+func (state *GameState) ShipsByPlayer(playerID string) []int {
+	playerState := state.playersStates[playerID]
+	return playerState.GetShips()
+}
+
 func (state *GameState) LongestRoadLengths() map[string]int {
 	longestRoadByPlayer := make(map[string]int)
 	for _, player := range state.players {
@@ -313,6 +505,10 @@ func (state *GameState) RobbablePlayers(playerID string) ([]string, error) {
 		return keys, err
 	}
 
+	if state.IsRobbingSuspended() {
+		return keys, nil
+	}
+
 	robbablePlayers := make(map[string]bool)
 	settlements := state.board.GetSettlements()
 	cities := state.board.GetCities()
@@ -332,7 +528,8 @@ func (state *GameState) RobbablePlayers(playerID string) ([]string, error) {
 		}
 	}
 	for ownerID := range robbablePlayers {
-		if ownerID != playerID {
+		// Neutral players have no cards to be robbed
+		if _, isPlayer := state.playersStates[ownerID]; isPlayer && ownerID != playerID {
 			keys = append(keys, ownerID)
 		}
 	}
@@ -371,7 +568,19 @@ func (state *GameState) Round() int {
 }
 
 func (state *GameState) EndGame() {
+	var err error
+	defer state.record(Command{Type: CommandEndGame})(&err)
+
 	state.round.SetRoundType(round.GameOver)
 	state.trade.CancelActiveTrades()
 	state.bookKeeping.AddPointsRecord(state.points)
+
+	winnerID := ""
+	for _, player := range state.players {
+		points := state.points[player.ID]
+		if points >= state.targetPoint && (winnerID == "" || points > state.points[winnerID]) {
+			winnerID = player.ID
+		}
+	}
+	state.emit(GameEnded{WinnerID: winnerID, Points: maps.Clone(state.points)})
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
@@ -75,7 +76,7 @@ func TestRobbablePlayers(t *testing.T) {
 }
 
 func TestDiscardAmountByPlayer(t *testing.T) {
-	createGame := func(resourceMap map[string]map[string]int) *GameState {
+	createGame := func(resourceMap map[string]coreT.Resources) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(round.DiscardPhase),
 			MockWithResourcesByPlayer(resourceMap),
@@ -85,32 +86,32 @@ func TestDiscardAmountByPlayer(t *testing.T) {
 
 	var tests = []struct {
 		description    string
-		resourceMap    map[string]map[string]int
+		resourceMap    map[string]coreT.Resources
 		expectedResult map[string]int
 	}{
 		{
 			description: "no player has more than 7 cards",
-			resourceMap: map[string]map[string]int{
+			resourceMap: map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 				"2": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 				"3": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 			},
 			expectedResult: map[string]int{
@@ -122,27 +123,27 @@ func TestDiscardAmountByPlayer(t *testing.T) {
 		},
 		{
 			description: "multiple player have more than 7 cards",
-			resourceMap: map[string]map[string]int{
+			resourceMap: map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  2,
-					"Sheep":  3,
-					"Grain":  4,
-					"Ore":    5, // total: 15
+					Lumber: 1,
+					Brick:  2,
+					Sheep:  3,
+					Grain:  4,
+					Ore:    5, // total: 15
 				},
 				"2": {
-					"Lumber": 1,
-					"Brick":  2,
-					"Sheep":  3,
-					"Grain":  4,
-					"Ore":    4, // total: 14
+					Lumber: 1,
+					Brick:  2,
+					Sheep:  3,
+					Grain:  4,
+					Ore:    4, // total: 14
 				},
 				"3": {
-					"Lumber": 1,
-					"Brick":  2,
-					"Sheep":  3,
-					"Grain":  4,
-					"Ore":    3, // total: 13
+					Lumber: 1,
+					Brick:  2,
+					Sheep:  3,
+					Grain:  4,
+					Ore:    3, // total: 13
 				},
 			},
 			expectedResult: map[string]int{
@@ -172,3 +173,25 @@ func TestDiscardAmountByPlayer(t *testing.T) {
 	}
 
 }
+
+func TestParamsFromMap(t *testing.T) {
+	t.Run("every key fills its own field", func(t *testing.T) {
+		values := make(map[string]int)
+		for i, key := range ParamsKeys() {
+			values[key] = i + 1
+		}
+		params := ParamsFromMap(values)
+		for i, field := range params.fields() {
+			if *field.value != i+1 {
+				t.Errorf("expected %s to be %d, actually got %d", field.key, i+1, *field.value)
+			}
+		}
+	})
+
+	t.Run("unknown keys are ignored", func(t *testing.T) {
+		params := ParamsFromMap(map[string]int{"targetPoint": 12, "unknown": 3})
+		if params != (Params{TargetPoint: 12}) {
+			t.Errorf("expected only TargetPoint to be set, actually got %+v", params)
+		}
+	})
+}
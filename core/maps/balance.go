@@ -0,0 +1,216 @@
+package maps
+
+import (
+	"math/rand"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Boards failing the constraints after this many attempts fall back to the best scoring one
+const maxBalanceAttempts = 5000
+
+// BalanceConstraints are the fairness rules a balanced board follows. Zero values disable a rule
+type BalanceConstraints struct {
+	// No 6 next to 8, nor 6 next to 6 and 8 next to 8
+	NoAdjacentRedNumbers bool
+	// No number next to itself
+	NoAdjacentSameNumbers bool
+	// Largest group of neighbouring tiles of the same resource
+	MaxResourceCluster int
+	// Upper bound of the variance of the average pips per tile of each resource
+	MaxPipVariance float64
+}
+
+var DefaultBalanceConstraints = BalanceConstraints{
+	NoAdjacentRedNumbers:  true,
+	NoAdjacentSameNumbers: true,
+	MaxResourceCluster:    2,
+	MaxPipVariance:        0.5,
+}
+
+// BalanceReport measures how fair a board is. Score goes from 0 to 100, the higher the fairer
+type BalanceReport struct {
+	AdjacentRedNumbers  int            `json:"adjacentRedNumbers"`
+	AdjacentSameNumbers int            `json:"adjacentSameNumbers"`
+	LargestClusters     map[string]int `json:"largestClusters"`
+	PipsByResource      map[string]int `json:"pipsByResource"`
+	PipVariance         float64        `json:"pipVariance"`
+	Score               float64        `json:"score"`
+}
+
+// Pips are the number of dice combinations rolling token, i.e. how often it produces
+func Pips(token int) int {
+	if token < 2 || token > 12 || token == 7 {
+		return 0
+	}
+	if token < 7 {
+		return token - 1
+	}
+	return 13 - token
+}
+
+func isRedNumber(token int) bool {
+	return token == 6 || token == 8
+}
+
+// AdjacentTiles returns the pairs of tiles sharing an edge, lower tile ID first
+func AdjacentTiles(definitions *MapDefinition) [][2]int {
+	tilesByEdge := make(map[int][]int)
+	for _, tileID := range definitions.Tiles {
+		for _, edgeID := range definitions.EdgesByTile[tileID] {
+			tilesByEdge[edgeID] = append(tilesByEdge[edgeID], tileID)
+		}
+	}
+	pairs := make([][2]int, 0)
+	for _, tileID := range definitions.Tiles {
+		for _, edgeID := range definitions.EdgesByTile[tileID] {
+			for _, neighbourID := range tilesByEdge[edgeID] {
+				if neighbourID > tileID {
+					pairs = append(pairs, [2]int{tileID, neighbourID})
+				}
+			}
+		}
+	}
+	return pairs
+}
+
+// Balance measures tiles, laid out on the map described by definitions
+func Balance(definitions *MapDefinition, tiles []coreT.MapBlock) BalanceReport {
+	report := BalanceReport{
+		LargestClusters: make(map[string]int),
+		PipsByResource:  make(map[string]int),
+	}
+
+	tilesByID := make(map[int]coreT.MapBlock)
+	for _, tile := range tiles {
+		tilesByID[tile.ID] = tile
+	}
+
+	// Union find over tiles of the same resource to get the clusters
+	parent := make(map[int]int)
+	var find func(tileID int) int
+	find = func(tileID int) int {
+		if parent[tileID] == tileID {
+			return tileID
+		}
+		parent[tileID] = find(parent[tileID])
+		return parent[tileID]
+	}
+	for _, tile := range tiles {
+		parent[tile.ID] = tile.ID
+	}
+
+	for _, pair := range AdjacentTiles(definitions) {
+		tile1 := tilesByID[pair[0]]
+		tile2 := tilesByID[pair[1]]
+		if isRedNumber(tile1.Token) && isRedNumber(tile2.Token) {
+			report.AdjacentRedNumbers++
+		}
+		if tile1.Token != 0 && tile1.Token == tile2.Token {
+			report.AdjacentSameNumbers++
+		}
+		if tile1.Resource == tile2.Resource {
+			parent[find(tile1.ID)] = find(tile2.ID)
+		}
+	}
+
+	clusterSizes := make(map[int]int)
+	tilesByResource := make(map[string]int)
+	for _, tile := range tiles {
+		// Gold fields produce any resource, so they don't tip the balance among them
+		if tile.Resource == "Desert" || tile.Resource == GoldResource {
+			continue
+		}
+		clusterSizes[find(tile.ID)]++
+		tilesByResource[tile.Resource]++
+		report.PipsByResource[tile.Resource] += Pips(tile.Token)
+	}
+	for _, tile := range tiles {
+		if size, exists := clusterSizes[find(tile.ID)]; exists && size > report.LargestClusters[tile.Resource] {
+			report.LargestClusters[tile.Resource] = size
+		}
+	}
+
+	// Following the definitions order keeps the float sums, and thus the generator, deterministic
+	averages := make([]float64, 0, len(tilesByResource))
+	mean := 0.0
+	for _, entry := range definitions.Resources {
+		count := tilesByResource[entry.Name]
+		if count == 0 {
+			continue
+		}
+		resource := entry.Name
+		average := float64(report.PipsByResource[resource]) / float64(count)
+		averages = append(averages, average)
+		mean += average
+	}
+	if len(averages) > 0 {
+		mean /= float64(len(averages))
+		for _, average := range averages {
+			report.PipVariance += (average - mean) * (average - mean)
+		}
+		report.PipVariance /= float64(len(averages))
+	}
+
+	score := 100.0
+	score -= 20 * float64(report.AdjacentRedNumbers)
+	score -= 5 * float64(report.AdjacentSameNumbers)
+	for _, size := range report.LargestClusters {
+		if size > 2 {
+			score -= 10 * float64(size-2)
+		}
+	}
+	score -= 20 * report.PipVariance
+	report.Score = max(score, 0)
+	return report
+}
+
+// SatisfiedBy tells whether report follows every enabled constraint
+func (constraints BalanceConstraints) SatisfiedBy(report BalanceReport) bool {
+	if constraints.NoAdjacentRedNumbers && report.AdjacentRedNumbers > 0 {
+		return false
+	}
+	if constraints.NoAdjacentSameNumbers && report.AdjacentSameNumbers > 0 {
+		return false
+	}
+	if constraints.MaxResourceCluster > 0 {
+		for _, size := range report.LargestClusters {
+			if size > constraints.MaxResourceCluster {
+				return false
+			}
+		}
+	}
+	if constraints.MaxPipVariance > 0 && report.PipVariance > constraints.MaxPipVariance {
+		return false
+	}
+	return true
+}
+
+// GenerateBalancedMap draws boards until one follows constraints.
+// As every draw comes from rand, the same seed always yields the same board
+func GenerateBalancedMap(definitions *MapDefinition, rand *rand.Rand, constraints BalanceConstraints) *GeneratedMap {
+	generated, _ := generateBalanced(definitions, constraints, func() (*GeneratedMap, error) {
+		return GenerateMap(definitions, rand), nil
+	})
+	return generated
+}
+
+func generateBalanced(definitions *MapDefinition, constraints BalanceConstraints, generate func() (*GeneratedMap, error)) (*GeneratedMap, error) {
+	var best *GeneratedMap
+	bestScore := -1.0
+	for attempt := 0; attempt < maxBalanceAttempts; attempt++ {
+		candidate, err := generate()
+		if err != nil {
+			return nil, err
+		}
+		report := Balance(definitions, candidate.Tiles)
+		if constraints.SatisfiedBy(report) {
+			return candidate, nil
+		}
+		if report.Score > bestScore {
+			best = candidate
+			bestScore = report.Score
+		}
+	}
+	return best, nil
+}
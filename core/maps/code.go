@@ -0,0 +1,160 @@
+package maps
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// A board code is a URL-safe base64 string recreating a generated board on the same map:
+// a version byte, then one byte per tile, in the same order as MapDefinition.Tiles, holding the index of its
+// resource in MapDefinition.Resources on the high nibble and its token on the low one, then one byte per port,
+// in the same order as MapDefinition.PortsLocations, holding the index of its type among the sorted port types,
+// and a last byte holding the index of the tile the robber starts at plus one, zero when there's no robber
+const boardCodeVersion = 1
+
+var boardCodeEncoding = base64.RawURLEncoding
+
+// Encode packs generated into a board code
+func Encode(definitions *MapDefinition, generated *GeneratedMap) (string, error) {
+	if len(generated.Tiles) != len(definitions.Tiles) {
+		err := fmt.Errorf("Cannot encode board: %d tiles for %d in the map", len(generated.Tiles), len(definitions.Tiles))
+		return "", err
+	}
+
+	content := make([]byte, 0, len(definitions.Tiles)+len(definitions.PortsLocations)+2)
+	content = append(content, boardCodeVersion)
+
+	robber := 0
+	for index, tile := range generated.Tiles {
+		resourceIndex := slices.IndexFunc(definitions.Resources, func(entry ResourceEntry) bool { return entry.Name == tile.Resource })
+		if resourceIndex == -1 || resourceIndex > 0xF {
+			err := fmt.Errorf("Cannot encode board: tile#%d resource %s can't be encoded", tile.ID, tile.Resource)
+			return "", err
+		}
+		if tile.Token < 0 || tile.Token > 0xF {
+			err := fmt.Errorf("Cannot encode board: tile#%d token %d can't be encoded", tile.ID, tile.Token)
+			return "", err
+		}
+		content = append(content, byte(resourceIndex<<4|tile.Token))
+		if tile.Blocked && robber == 0 {
+			robber = index + 1
+		}
+	}
+
+	portTypes := sortedKeys(definitions.PortsByDefinition)
+	for _, location := range definitions.PortsLocations {
+		portIndex := slices.Index(portTypes, generated.Ports[location[0]])
+		if portIndex == -1 {
+			err := fmt.Errorf("Cannot encode board: port at %v has unknown type %s", location, generated.Ports[location[0]])
+			return "", err
+		}
+		content = append(content, byte(portIndex))
+	}
+
+	content = append(content, byte(robber))
+	return boardCodeEncoding.EncodeToString(content), nil
+}
+
+// Decode lays out the board code describes, checking it fits the map tiles, tokens and ports
+func Decode(definitions *MapDefinition, code string) (*GeneratedMap, error) {
+	content, err := boardCodeEncoding.DecodeString(code)
+	if err != nil {
+		err := fmt.Errorf("Cannot decode board code: %w", err)
+		return nil, err
+	}
+	expectedLength := len(definitions.Tiles) + len(definitions.PortsLocations) + 2
+	if len(content) != expectedLength {
+		err := fmt.Errorf("Cannot decode board code: expected %d bytes, got %d", expectedLength, len(content))
+		return nil, err
+	}
+	if content[0] != boardCodeVersion {
+		err := fmt.Errorf("Cannot decode board code: unknown version %d", content[0])
+		return nil, err
+	}
+
+	tilesContent := content[1 : 1+len(definitions.Tiles)]
+	portsContent := content[1+len(definitions.Tiles) : len(content)-1]
+	robber := int(content[len(content)-1])
+
+	instance := make([]coreT.MapBlock, len(definitions.Tiles))
+	resourcesCount := make([]int, len(definitions.Resources))
+	tokens := make([]int, 0, len(definitions.Tokens))
+	for index, tileID := range definitions.Tiles {
+		resourceIndex := int(tilesContent[index] >> 4)
+		if resourceIndex >= len(definitions.Resources) {
+			err := fmt.Errorf("Cannot decode board code: tile#%d has unknown resource %d", tileID, resourceIndex)
+			return nil, err
+		}
+		resourcesCount[resourceIndex]++
+		instance[index].Resource = definitions.Resources[resourceIndex].Name
+		instance[index].Token = int(tilesContent[index] & 0xF)
+		if instance[index].Resource == "Desert" && instance[index].Token != 0 {
+			err := fmt.Errorf("Cannot decode board code: desert tile#%d has token %d", tileID, instance[index].Token)
+			return nil, err
+		}
+		if instance[index].Resource != "Desert" && (instance[index].Token < 2 || instance[index].Token > 12) {
+			err := fmt.Errorf("Cannot decode board code: tile#%d has invalid token %d", tileID, instance[index].Token)
+			return nil, err
+		}
+		if instance[index].Token > 0 {
+			tokens = append(tokens, instance[index].Token)
+		}
+		placeTile(definitions, &instance[index], tileID)
+	}
+	for resourceIndex, entry := range definitions.Resources {
+		if resourcesCount[resourceIndex] != entry.Count {
+			err := fmt.Errorf("Cannot decode board code: %d %s tiles, but the map has %d", resourcesCount[resourceIndex], entry.Name, entry.Count)
+			return nil, err
+		}
+	}
+	slices.Sort(tokens)
+	if !slices.Equal(tokens, slices.Sorted(slices.Values(definitions.Tokens))) {
+		err := fmt.Errorf("Cannot decode board code: tokens %v don't match the map ones", tokens)
+		return nil, err
+	}
+
+	portTypes := sortedKeys(definitions.PortsByDefinition)
+	ports := make([]string, len(definitions.PortsLocations))
+	portsCount := make(map[string]int)
+	for index, portIndex := range portsContent {
+		if int(portIndex) >= len(portTypes) {
+			err := fmt.Errorf("Cannot decode board code: port at %v has unknown type %d", definitions.PortsLocations[index], portIndex)
+			return nil, err
+		}
+		ports[index] = portTypes[portIndex]
+		portsCount[ports[index]]++
+	}
+	for _, portType := range portTypes {
+		if portsCount[portType] != definitions.PortsByDefinition[portType] {
+			err := fmt.Errorf("Cannot decode board code: %d %s ports, but the map has %d", portsCount[portType], portType, definitions.PortsByDefinition[portType])
+			return nil, err
+		}
+	}
+
+	robberPosition := robber - 1
+	if robberPosition >= len(instance) {
+		err := fmt.Errorf("Cannot decode board code: robber starts at unknown tile index %d", robberPosition)
+		return nil, err
+	}
+	hasDesert := slices.ContainsFunc(instance, func(tile coreT.MapBlock) bool { return tile.Resource == "Desert" })
+	if robberPosition < 0 && hasDesert {
+		err := fmt.Errorf("Cannot decode board code: robber must start at a desert")
+		return nil, err
+	}
+	if robberPosition >= 0 {
+		if instance[robberPosition].Resource != "Desert" {
+			err := fmt.Errorf("Cannot decode board code: robber must start at a desert")
+			return nil, err
+		}
+		instance[robberPosition].Blocked = true
+	}
+
+	return &GeneratedMap{
+		RobberPosition: robberPosition,
+		Ports:          portsFromSlice(definitions, ports),
+		Tiles:          instance,
+	}, nil
+}
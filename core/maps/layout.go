@@ -0,0 +1,202 @@
+package maps
+
+import (
+	"fmt"
+	"math/rand"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// Layout is how resources, tokens and ports are laid out on a new board
+type Layout int
+
+const (
+	// Resources, tokens and ports are shuffled
+	LayoutRandom Layout = iota
+	// Resources and ports are shuffled, tokens follow the official spiral from a random corner
+	LayoutSpiral
+	// Resources, tokens and ports come from the map FixedLayout, e.g. the beginner board
+	LayoutFixed
+)
+
+type FixedTile struct {
+	Resource string `json:"resource"`
+	Token    int    `json:"token"`
+}
+
+// FixedLayout describes a board that is always the same
+type FixedLayout struct {
+	// One entry per tile, in the same order as MapDefinition.Tiles
+	Tiles []FixedTile `json:"tiles"`
+	// One entry per port, in the same order as MapDefinition.PortsLocations
+	Ports []string `json:"ports"`
+}
+
+// Cube directions, walked in order they go around a ring counterclockwise
+var hexDirections = [6][3]int{
+	{1, 0, -1},
+	{1, -1, 0},
+	{0, -1, 1},
+	{-1, 0, 1},
+	{-1, 1, 0},
+	{0, 1, -1},
+}
+
+// Generate lays out a board following layout. With balance set, shuffled boards follow its constraints
+func Generate(definitions *MapDefinition, rand *rand.Rand, layout Layout, balance *BalanceConstraints) (*GeneratedMap, error) {
+	switch layout {
+	case LayoutRandom:
+		if balance != nil {
+			return GenerateBalancedMap(definitions, rand, *balance), nil
+		}
+		return GenerateMap(definitions, rand), nil
+	case LayoutSpiral:
+		if len(definitions.SpiralTokens) == 0 {
+			err := fmt.Errorf("Cannot generate spiral layout: map has no spiral tokens")
+			return nil, err
+		}
+		if balance != nil {
+			return generateBalanced(definitions, *balance, func() (*GeneratedMap, error) {
+				return GenerateSpiralMap(definitions, rand)
+			})
+		}
+		return GenerateSpiralMap(definitions, rand)
+	case LayoutFixed:
+		return GenerateFixedMap(definitions)
+	default:
+		err := fmt.Errorf("Cannot generate map: unknown layout %d", layout)
+		return nil, err
+	}
+}
+
+// SpiralOrder lists the tiles from the corner towards the center, going counterclockwise.
+// corner goes from 0 to 5
+func SpiralOrder(definitions *MapDefinition, corner int) []int {
+	tileByCoordinates := make(map[[3]int]int)
+	radius := 0
+	for _, tileID := range definitions.Tiles {
+		coordinates := definitions.HexCoordinatesByTile[tileID]
+		tileByCoordinates[coordinates] = tileID
+		radius = max(radius, hexDistanceToCenter(coordinates))
+	}
+
+	order := make([]int, 0, len(definitions.Tiles))
+	for ring := radius; ring > 0; ring-- {
+		direction := hexDirections[corner]
+		hex := [3]int{direction[0] * ring, direction[1] * ring, direction[2] * ring}
+		for side := 0; side < 6; side++ {
+			step := hexDirections[(corner+2+side)%6]
+			for i := 0; i < ring; i++ {
+				if tileID, exists := tileByCoordinates[hex]; exists {
+					order = append(order, tileID)
+				}
+				hex = [3]int{hex[0] + step[0], hex[1] + step[1], hex[2] + step[2]}
+			}
+		}
+	}
+	if tileID, exists := tileByCoordinates[[3]int{0, 0, 0}]; exists {
+		order = append(order, tileID)
+	}
+	return order
+}
+
+func hexDistanceToCenter(coordinates [3]int) int {
+	distance := 0
+	for _, coordinate := range coordinates {
+		if coordinate < 0 {
+			coordinate = -coordinate
+		}
+		distance = max(distance, coordinate)
+	}
+	return distance
+}
+
+// GenerateSpiralMap shuffles resources and ports, then places the spiral tokens skipping the desert
+func GenerateSpiralMap(definitions *MapDefinition, rand *rand.Rand) (*GeneratedMap, error) {
+	resources := make([]string, 0, len(definitions.Tiles))
+	for _, resourceEntry := range definitions.Resources {
+		for i := 0; i < resourceEntry.Count; i++ {
+			resources = append(resources, resourceEntry.Name)
+		}
+	}
+	if len(resources) != len(definitions.Tiles) {
+		err := fmt.Errorf("Cannot generate spiral layout: %d resources for %d tiles", len(resources), len(definitions.Tiles))
+		return nil, err
+	}
+	utils.SliceShuffle(resources, rand)
+
+	order := SpiralOrder(definitions, rand.Intn(len(hexDirections)))
+	if len(order) != len(definitions.Tiles) {
+		err := fmt.Errorf("Cannot generate spiral layout: tiles are not laid out around a center tile")
+		return nil, err
+	}
+
+	indexByTile := make(map[int]int)
+	instance := make([]coreT.MapBlock, len(definitions.Tiles))
+	for index, tileID := range definitions.Tiles {
+		indexByTile[tileID] = index
+		instance[index].Resource = resources[index]
+		placeTile(definitions, &instance[index], tileID)
+	}
+
+	robberPosition := -1
+	tokenIndex := 0
+	for _, tileID := range order {
+		index := indexByTile[tileID]
+		if instance[index].Resource == "Desert" {
+			if robberPosition == -1 {
+				instance[index].Blocked = true
+				robberPosition = index
+			}
+			continue
+		}
+		if tokenIndex >= len(definitions.SpiralTokens) {
+			err := fmt.Errorf("Cannot generate spiral layout: not enough spiral tokens")
+			return nil, err
+		}
+		instance[index].Token = definitions.SpiralTokens[tokenIndex]
+		tokenIndex++
+	}
+
+	return &GeneratedMap{
+		RobberPosition: robberPosition,
+		Ports:          generatePorts(definitions, rand),
+		Tiles:          instance,
+	}, nil
+}
+
+// GenerateFixedMap lays out the map FixedLayout
+func GenerateFixedMap(definitions *MapDefinition) (*GeneratedMap, error) {
+	layout := definitions.FixedLayout
+	if layout == nil {
+		err := fmt.Errorf("Cannot generate fixed layout: map has no fixed layout")
+		return nil, err
+	}
+	if len(layout.Tiles) != len(definitions.Tiles) {
+		err := fmt.Errorf("Cannot generate fixed layout: %d tiles defined for %d tiles", len(layout.Tiles), len(definitions.Tiles))
+		return nil, err
+	}
+	if len(layout.Ports) != len(definitions.PortsLocations) {
+		err := fmt.Errorf("Cannot generate fixed layout: %d ports defined for %d locations", len(layout.Ports), len(definitions.PortsLocations))
+		return nil, err
+	}
+
+	robberPosition := -1
+	instance := make([]coreT.MapBlock, len(definitions.Tiles))
+	for index, tileID := range definitions.Tiles {
+		instance[index].Resource = layout.Tiles[index].Resource
+		instance[index].Token = layout.Tiles[index].Token
+		if instance[index].Resource == "Desert" && robberPosition == -1 {
+			instance[index].Blocked = true
+			robberPosition = index
+		}
+		placeTile(definitions, &instance[index], tileID)
+	}
+
+	return &GeneratedMap{
+		RobberPosition: robberPosition,
+		Ports:          portsFromSlice(definitions, layout.Ports),
+		Tiles:          instance,
+	}, nil
+}
@@ -1,12 +1,12 @@
 package maps
 
 import (
-	"encoding/json"
+	"embed"
 	"fmt"
-	"io"
+	"io/fs"
 	"math/rand"
-	"os"
-	"path/filepath"
+	"path"
+	"strings"
 
 	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/logger"
@@ -31,6 +31,17 @@ type MapDefinition struct {
 	VerticesByEdge       map[int][2]int  `json:"verticesByEdge"`
 	EdgesByVertex        map[int][]int   `json:"edgesByVertex"`
 	DevelopmentCards     map[string]int  `json:"developmentCards"`
+	// Tokens of the official spiral placement, in alphabetical order
+	SpiralTokens []int        `json:"spiralTokens,omitempty"`
+	FixedLayout  *FixedLayout `json:"fixedLayout,omitempty"`
+	// Tiles with hex coordinates but no resource, only sailed through by ships
+	SeaTiles []int `json:"seaTiles,omitempty"`
+	// Edges between a land and a sea tile, where both roads and ships may sit
+	CoastalEdges map[int]bool `json:"coastalEdges,omitempty"`
+	// Edges with no land tile around, where only ships may sit
+	SeaEdges map[int]bool `json:"seaEdges,omitempty"`
+	// Tiles revealed from the start in fog-of-war games, the others stay hidden until explored
+	StartingRegion []int `json:"startingRegion,omitempty"`
 }
 
 type meta struct {
@@ -54,7 +65,15 @@ type jsonStructure struct {
 	Meta meta
 }
 
-type generateMapReturnType struct {
+const (
+	SeaResource = "Sea"
+	// Gold fields produce whichever resource their owners pick
+	GoldResource = "Gold"
+	// Stands for the resource of a tile not explored yet
+	HiddenResource = "Hidden"
+)
+
+type GeneratedMap struct {
 	Tiles          []coreT.MapBlock
 	RobberPosition int
 	Ports          map[int]string
@@ -62,38 +81,53 @@ type generateMapReturnType struct {
 
 var MapCollection map[string]jsonStructure = make(map[string]jsonStructure)
 
+//go:embed data/*.json
+var mapFiles embed.FS
+
 func LoadMap(name string) error {
 	filename := fmt.Sprintf("%s.json", name)
-	filePath := filepath.Join("core", "maps", "data", filename)
 	action := fmt.Sprintf("LoadMap.%s", name)
 
-	// Open the file
-	file, err := os.Open(filePath)
-	if err != nil {
-		logger.LogSystemError(action, -1, err)
-		return fmt.Errorf("failed to open file: %w", err)
-	}
-	defer file.Close()
-
-	// Read file contents
-	content, err := io.ReadAll(file)
+	content, err := mapFiles.ReadFile(path.Join("data", filename))
 	if err != nil {
 		logger.LogSystemError(action, -1, err)
 		return fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Unmarshal JSON into Definition
-	var data jsonStructure
-	if err := json.Unmarshal(content, &data); err != nil {
+	data, err := parseMap(content)
+	if err != nil {
 		logger.LogSystemError(action, -1, err)
-		return fmt.Errorf("failed to unmarshal JSON: %w", err)
+		return err
 	}
 
-	MapCollection[name] = data
+	MapCollection[name] = *data
 	logger.LogSystemMessage(action, "loaded map successfully")
 	return nil
 }
 
+// LoadAllMaps loads every map embedded in the data directory
+func LoadAllMaps() error {
+	entries, err := fs.ReadDir(mapFiles, "data")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name, isJSON := strings.CutSuffix(entry.Name(), ".json")
+		if !isJSON {
+			continue
+		}
+		if err := LoadMap(name); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// AvailableMaps lists the loaded maps names, sorted
+func AvailableMaps() []string {
+	return sortedKeys(MapCollection)
+}
+
 func GetMapDefinitions(name string) (*MapDefinition, error) {
 	data, exists := MapCollection[name]
 	if !exists {
@@ -103,7 +137,7 @@ func GetMapDefinitions(name string) (*MapDefinition, error) {
 	return &data.Data, nil
 }
 
-func GenerateMap(definitions *MapDefinition, rand *rand.Rand) *generateMapReturnType {
+func GenerateMap(definitions *MapDefinition, rand *rand.Rand) *GeneratedMap {
 	robberPosition := -1
 
 	instance := make([]coreT.MapBlock, 0)
@@ -156,37 +190,56 @@ func GenerateMap(definitions *MapDefinition, rand *rand.Rand) *generateMapReturn
 	utils.SliceShuffle(instance, rand)
 
 	for index := range instance {
-		tileID := index + 1
-		instance[index].ID = tileID
-		instance[index].Vertices = definitions.VerticesByTile[tileID]
-		instance[index].Edges = definitions.EdgesByTile[tileID]
-		instance[index].Coordinates = coreT.HexCoordinate{
-			Q: definitions.HexCoordinatesByTile[tileID][0],
-			R: definitions.HexCoordinatesByTile[tileID][1],
-			S: definitions.HexCoordinatesByTile[tileID][2],
-		}
+		placeTile(definitions, &instance[index], index+1)
+	}
+
+	return &GeneratedMap{
+		RobberPosition: robberPosition,
+		Ports:          generatePorts(definitions, rand),
+		Tiles:          instance,
+	}
+}
+
+func placeTile(definitions *MapDefinition, tile *coreT.MapBlock, tileID int) {
+	tile.ID = tileID
+	tile.Vertices = definitions.VerticesByTile[tileID]
+	tile.Edges = definitions.EdgesByTile[tileID]
+	tile.Coordinates = coreT.HexCoordinate{
+		Q: definitions.HexCoordinatesByTile[tileID][0],
+		R: definitions.HexCoordinatesByTile[tileID][1],
+		S: definitions.HexCoordinatesByTile[tileID][2],
+	}
+}
+
+// SeaBlocks lays out the sea tiles, which are the same on every board
+func SeaBlocks(definitions *MapDefinition) []coreT.MapBlock {
+	blocks := make([]coreT.MapBlock, len(definitions.SeaTiles))
+	for index, tileID := range definitions.SeaTiles {
+		blocks[index].Resource = SeaResource
+		placeTile(definitions, &blocks[index], tileID)
 	}
+	return blocks
+}
 
+func generatePorts(definitions *MapDefinition, rand *rand.Rand) map[int]string {
 	// NOTE: this is done to enforce ordering for tests (math/random seed)
 	portsDefinitions := MapToShuffledSlice(
 		definitions.PortsByDefinition,
 		func(el string) string { return el },
 		rand,
 	)
-	portsVertices := definitions.PortsLocations
+	return portsFromSlice(definitions, portsDefinitions)
+}
+
+func portsFromSlice(definitions *MapDefinition, portsDefinitions []string) map[int]string {
 	ports := make(map[int]string)
-	for index, port := range portsVertices {
+	for index, port := range definitions.PortsLocations {
 		vertex1 := port[0]
 		vertex2 := port[1]
 		ports[vertex1] = portsDefinitions[index]
 		ports[vertex2] = portsDefinitions[index]
 	}
-
-	return &generateMapReturnType{
-		RobberPosition: robberPosition,
-		Ports:          ports,
-		Tiles:          instance,
-	}
+	return ports
 }
 
 func GetMetadata(mapName string) (*meta, error) {
@@ -0,0 +1,130 @@
+package maps
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Corners of a pointy top hex, clockwise from the top one, on a grid where a hex center is at (2q + r, 3r)
+var cornerOffsets = [6][2]int{
+	{0, -2},
+	{1, -1},
+	{1, 1},
+	{0, 2},
+	{-1, 1},
+	{-1, -1},
+}
+
+// BuildTopology derives vertices and edges tables from HexCoordinatesByTile.
+// Vertices and edges are numbered as they first appear going through Tiles then SeaTiles in order,
+// corners clockwise from the top one and edge i going from corner i to corner i+1
+func BuildTopology(definitions *MapDefinition) error {
+	verticesByTile := make(map[int][6]int)
+	edgesByTile := make(map[int][6]int)
+	tilesByVertex := make(map[int][]int)
+	verticesByEdge := make(map[int][2]int)
+	edgesByVertex := make(map[int][]int)
+
+	vertexByPoint := make(map[[2]int]int)
+	edgeByVertices := make(map[[2]int]int)
+	tileByCoordinates := make(map[[3]int]int)
+
+	landTilesByEdge := make(map[int]int)
+	seaTilesByEdge := make(map[int]int)
+
+	addTile := func(tileIndex, tileID int, isSea bool) error {
+		coordinates, exists := definitions.HexCoordinatesByTile[tileID]
+		if !exists {
+			err := fmt.Errorf("Cannot build topology: tile#%d has no hex coordinates", tileID)
+			return err
+		}
+		if coordinates[0]+coordinates[1]+coordinates[2] != 0 {
+			err := fmt.Errorf("Cannot build topology: tile#%d coordinates %v don't sum to zero", tileID, coordinates)
+			return err
+		}
+		if otherTileID, exists := tileByCoordinates[coordinates]; exists {
+			err := fmt.Errorf("Cannot build topology: tiles #%d and #%d share coordinates %v", otherTileID, tileID, coordinates)
+			return err
+		}
+		tileByCoordinates[coordinates] = tileID
+
+		centerX := 2*coordinates[0] + coordinates[1]
+		centerY := 3 * coordinates[1]
+		var vertices [6]int
+		for corner, offset := range cornerOffsets {
+			point := [2]int{centerX + offset[0], centerY + offset[1]}
+			vertexID, exists := vertexByPoint[point]
+			if !exists {
+				vertexID = len(vertexByPoint) + 1
+				vertexByPoint[point] = vertexID
+			}
+			vertices[corner] = vertexID
+			// Sea tiles aren't indexed by vertex, since they don't produce
+			if !isSea {
+				tilesByVertex[vertexID] = append(tilesByVertex[vertexID], tileIndex)
+			}
+		}
+
+		var edges [6]int
+		for corner := range cornerOffsets {
+			pair := [2]int{vertices[corner], vertices[(corner+1)%6]}
+			if pair[0] > pair[1] {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			edgeID, exists := edgeByVertices[pair]
+			if !exists {
+				edgeID = len(edgeByVertices) + 1
+				edgeByVertices[pair] = edgeID
+				verticesByEdge[edgeID] = pair
+				edgesByVertex[pair[0]] = append(edgesByVertex[pair[0]], edgeID)
+				edgesByVertex[pair[1]] = append(edgesByVertex[pair[1]], edgeID)
+			}
+			edges[corner] = edgeID
+			if isSea {
+				seaTilesByEdge[edgeID]++
+			} else {
+				landTilesByEdge[edgeID]++
+			}
+		}
+
+		verticesByTile[tileID] = vertices
+		edgesByTile[tileID] = edges
+		return nil
+	}
+
+	// Land tiles go first, so adding sea around a map keeps its numbering
+	for tileIndex, tileID := range definitions.Tiles {
+		if err := addTile(tileIndex, tileID, false); err != nil {
+			return err
+		}
+	}
+	for tileIndex, tileID := range definitions.SeaTiles {
+		if err := addTile(tileIndex, tileID, true); err != nil {
+			return err
+		}
+	}
+
+	for _, edges := range edgesByVertex {
+		sort.Ints(edges)
+	}
+
+	definitions.VerticesByTile = verticesByTile
+	definitions.EdgesByTile = edgesByTile
+	definitions.TilesByVertex = tilesByVertex
+	definitions.VerticesByEdge = verticesByEdge
+	definitions.EdgesByVertex = edgesByVertex
+	definitions.CoastalEdges = nil
+	definitions.SeaEdges = nil
+	if len(definitions.SeaTiles) > 0 {
+		definitions.CoastalEdges = make(map[int]bool)
+		definitions.SeaEdges = make(map[int]bool)
+		for edgeID := range verticesByEdge {
+			if landTilesByEdge[edgeID] == 0 {
+				definitions.SeaEdges[edgeID] = true
+			} else if seaTilesByEdge[edgeID] > 0 {
+				definitions.CoastalEdges[edgeID] = true
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// ParseMap reads a map JSON, deriving its topology when not listed, and validates it
+func ParseMap(content []byte) (*MapDefinition, error) {
+	data, err := parseMap(content)
+	if err != nil {
+		return nil, err
+	}
+	return &data.Data, nil
+}
+
+func parseMap(content []byte) (*jsonStructure, error) {
+	var data jsonStructure
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+
+	// Tables listed by hand take precedence over the derived ones
+	if len(data.Data.VerticesByTile) == 0 {
+		if err := BuildTopology(&data.Data); err != nil {
+			return nil, fmt.Errorf("failed to build topology: %w", err)
+		}
+	}
+
+	if err := errors.Join(Validate(&data.Data), data.Meta.validate()); err != nil {
+		return nil, fmt.Errorf("invalid map:\n%w", err)
+	}
+	return &data, nil
+}
+
+// Validate checks definitions are internally consistent, returning every problem found
+func Validate(definitions *MapDefinition) error {
+	problems := make([]error, 0)
+	problems = append(problems, validateTopology(definitions)...)
+	problems = append(problems, validatePorts(definitions)...)
+	problems = append(problems, validateDeck(definitions)...)
+	return errors.Join(problems...)
+}
+
+func validateTopology(definitions *MapDefinition) []error {
+	problems := make([]error, 0)
+	if len(definitions.Tiles) == 0 {
+		problems = append(problems, fmt.Errorf("map has no tiles"))
+	}
+
+	seenTiles := make(map[int]bool)
+	for tileIndex, tileID := range definitions.Tiles {
+		if seenTiles[tileID] {
+			problems = append(problems, fmt.Errorf("tile#%d is listed more than once", tileID))
+			continue
+		}
+		seenTiles[tileID] = true
+
+		vertices, exists := definitions.VerticesByTile[tileID]
+		if !exists {
+			problems = append(problems, fmt.Errorf("tile#%d has no vertices", tileID))
+			continue
+		}
+		edges, exists := definitions.EdgesByTile[tileID]
+		if !exists {
+			problems = append(problems, fmt.Errorf("tile#%d has no edges", tileID))
+			continue
+		}
+		if !distinct(vertices) {
+			problems = append(problems, fmt.Errorf("tile#%d vertices %v aren't six distinct ones", tileID, vertices))
+		}
+		if !distinct(edges) {
+			problems = append(problems, fmt.Errorf("tile#%d edges %v aren't six distinct ones", tileID, edges))
+		}
+
+		for corner, vertexID := range vertices {
+			if !utils.SliceContains(definitions.TilesByVertex[vertexID], tileIndex) {
+				problems = append(problems, fmt.Errorf("vertex#%d is in tile#%d but tilesByVertex doesn't list it", vertexID, tileID))
+			}
+			edgeID := edges[corner]
+			endpoints, exists := definitions.VerticesByEdge[edgeID]
+			nextVertexID := vertices[(corner+1)%6]
+			if !exists || !sameEndpoints(endpoints, vertexID, nextVertexID) {
+				problems = append(problems, fmt.Errorf("edge#%d of tile#%d should go from vertex#%d to vertex#%d, but verticesByEdge has %v", edgeID, tileID, vertexID, nextVertexID, endpoints))
+			}
+		}
+	}
+
+	for _, tileID := range definitions.SeaTiles {
+		if seenTiles[tileID] {
+			problems = append(problems, fmt.Errorf("tile#%d is listed more than once", tileID))
+			continue
+		}
+		seenTiles[tileID] = true
+		if _, exists := definitions.VerticesByTile[tileID]; !exists {
+			problems = append(problems, fmt.Errorf("sea tile#%d has no vertices", tileID))
+		}
+	}
+	for _, tileID := range definitions.StartingRegion {
+		if !utils.SliceContains(definitions.Tiles, tileID) {
+			problems = append(problems, fmt.Errorf("starting region lists tile#%d, which isn't a land tile", tileID))
+		}
+	}
+	if len(definitions.SeaTiles) > 0 && definitions.CoastalEdges == nil {
+		problems = append(problems, fmt.Errorf("sea tiles require the topology to be derived from hex coordinates"))
+	}
+
+	for _, vertexID := range sortedKeys(definitions.TilesByVertex) {
+		for _, tileIndex := range definitions.TilesByVertex[vertexID] {
+			if tileIndex < 0 || tileIndex >= len(definitions.Tiles) {
+				problems = append(problems, fmt.Errorf("tilesByVertex lists unknown tile index %d for vertex#%d", tileIndex, vertexID))
+				continue
+			}
+			tileID := definitions.Tiles[tileIndex]
+			vertices := definitions.VerticesByTile[tileID]
+			if !utils.SliceContains(vertices[:], vertexID) {
+				problems = append(problems, fmt.Errorf("tilesByVertex lists tile#%d for vertex#%d, but the tile doesn't have it", tileID, vertexID))
+			}
+		}
+	}
+
+	for _, edgeID := range sortedKeys(definitions.VerticesByEdge) {
+		for _, vertexID := range definitions.VerticesByEdge[edgeID] {
+			if !utils.SliceContains(definitions.EdgesByVertex[vertexID], edgeID) {
+				problems = append(problems, fmt.Errorf("edge#%d touches vertex#%d, but edgesByVertex doesn't list it", edgeID, vertexID))
+			}
+		}
+	}
+	for _, vertexID := range sortedKeys(definitions.EdgesByVertex) {
+		for _, edgeID := range definitions.EdgesByVertex[vertexID] {
+			endpoints := definitions.VerticesByEdge[edgeID]
+			if endpoints[0] != vertexID && endpoints[1] != vertexID {
+				problems = append(problems, fmt.Errorf("edgesByVertex lists edge#%d for vertex#%d, but the edge doesn't touch it", edgeID, vertexID))
+			}
+		}
+	}
+	return problems
+}
+
+func validatePorts(definitions *MapDefinition) []error {
+	problems := make([]error, 0)
+
+	tilesByEdge := make(map[int]int)
+	for _, tileID := range definitions.Tiles {
+		for _, edgeID := range definitions.EdgesByTile[tileID] {
+			tilesByEdge[edgeID]++
+		}
+	}
+
+	seenVertices := make(map[int]bool)
+	for _, location := range definitions.PortsLocations {
+		vertex1, vertex2 := location[0], location[1]
+		for _, vertexID := range location {
+			if seenVertices[vertexID] {
+				problems = append(problems, fmt.Errorf("vertex#%d has more than one port", vertexID))
+			}
+			seenVertices[vertexID] = true
+		}
+		edgeID := -1
+		for _, candidate := range definitions.EdgesByVertex[vertex1] {
+			if sameEndpoints(definitions.VerticesByEdge[candidate], vertex1, vertex2) {
+				edgeID = candidate
+			}
+		}
+		if edgeID == -1 {
+			problems = append(problems, fmt.Errorf("port at %v isn't on adjacent vertices", location))
+			continue
+		}
+		if tilesByEdge[edgeID] != 1 {
+			problems = append(problems, fmt.Errorf("port at %v isn't on the coast", location))
+		}
+	}
+
+	ports := 0
+	for _, name := range sortedKeys(definitions.PortsByDefinition) {
+		count := definitions.PortsByDefinition[name]
+		if count < 0 {
+			problems = append(problems, fmt.Errorf("%s ports count is negative: %d", name, count))
+		}
+		ports += count
+	}
+	if ports != len(definitions.PortsLocations) {
+		problems = append(problems, fmt.Errorf("%d ports defined for %d locations", ports, len(definitions.PortsLocations)))
+	}
+	return problems
+}
+
+func validateDeck(definitions *MapDefinition) []error {
+	problems := make([]error, 0)
+
+	resources := 0
+	deserts := 0
+	for _, entry := range definitions.Resources {
+		if entry.Count < 0 {
+			problems = append(problems, fmt.Errorf("%s tiles count is negative: %d", entry.Name, entry.Count))
+		}
+		if entry.Name != "Desert" && entry.Name != GoldResource && coreT.Resource(entry.Name).Validate() != nil {
+			problems = append(problems, fmt.Errorf("unknown tile resource %s", entry.Name))
+		}
+		resources += entry.Count
+		if entry.Name == "Desert" {
+			deserts += entry.Count
+		}
+	}
+	if resources != len(definitions.Tiles) {
+		problems = append(problems, fmt.Errorf("resources sum up to %d tiles, but the map has %d", resources, len(definitions.Tiles)))
+	}
+
+	producingTiles := len(definitions.Tiles) - deserts
+	if len(definitions.Tokens) != producingTiles {
+		problems = append(problems, fmt.Errorf("%d tokens for %d non desert tiles", len(definitions.Tokens), producingTiles))
+	}
+	for _, token := range definitions.Tokens {
+		if Pips(token) == 0 {
+			problems = append(problems, fmt.Errorf("invalid token %d", token))
+		}
+	}
+	if len(definitions.SpiralTokens) > 0 {
+		spiralTokens := append([]int{}, definitions.SpiralTokens...)
+		tokens := append([]int{}, definitions.Tokens...)
+		sort.Ints(spiralTokens)
+		sort.Ints(tokens)
+		if !utils.SliceEqual(spiralTokens, tokens) {
+			problems = append(problems, fmt.Errorf("spiral tokens aren't the same as tokens"))
+		}
+	}
+
+	if layout := definitions.FixedLayout; layout != nil {
+		if len(layout.Tiles) != len(definitions.Tiles) {
+			problems = append(problems, fmt.Errorf("fixed layout has %d tiles, but the map has %d", len(layout.Tiles), len(definitions.Tiles)))
+		}
+		if len(layout.Ports) != len(definitions.PortsLocations) {
+			problems = append(problems, fmt.Errorf("fixed layout has %d ports for %d locations", len(layout.Ports), len(definitions.PortsLocations)))
+		}
+	}
+
+	for _, name := range sortedKeys(definitions.DevelopmentCards) {
+		if count := definitions.DevelopmentCards[name]; count < 0 {
+			problems = append(problems, fmt.Errorf("%s development cards count is negative: %d", name, count))
+		}
+	}
+	return problems
+}
+
+func (m *meta) validate() error {
+	problems := make([]error, 0)
+	if m.Players.Min < 1 || m.Players.Min > m.Players.Max {
+		problems = append(problems, fmt.Errorf("players range %d to %d is invalid", m.Players.Min, m.Players.Max))
+	}
+	for _, key := range sortedKeys(m.Params) {
+		param := m.Params[key]
+		if !utils.SliceContains(param.Values, param.Default) {
+			problems = append(problems, fmt.Errorf("param %s default %d isn't one of its values %v", key, param.Default, param.Values))
+		}
+	}
+	return errors.Join(problems...)
+}
+
+func distinct(ids [6]int) bool {
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+	return true
+}
+
+func sameEndpoints(endpoints [2]int, vertex1, vertex2 int) bool {
+	return (endpoints[0] == vertex1 && endpoints[1] == vertex2) || (endpoints[0] == vertex2 && endpoints[1] == vertex1)
+}
+
+func sortedKeys[K int | string, V any](instance map[K]V) []K {
+	keys := make([]K, 0, len(instance))
+	for key := range instance {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,22 @@
+package core
+
+import (
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+func TestLoadAllMaps(t *testing.T) {
+	err := coreMaps.LoadAllMaps()
+	if err != nil {
+		t.Fatalf("expected to load every embedded map just fine, but actually got error %s", err.Error())
+	}
+	maps := coreMaps.AvailableMaps()
+	if !utils.SliceContains(maps, "base4") {
+		t.Errorf("expected base4 to be available, actually got %v", maps)
+	}
+	if err := coreMaps.LoadMap("unknown"); err == nil {
+		t.Errorf("expected to have error since map isn't embedded, but actually no error was found")
+	}
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+)
+
+func readBase4(t *testing.T) map[string]any {
+	_, file, _, _ := runtime.Caller(0)
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(file), "maps", "data", "base4.json"))
+	if err != nil {
+		t.Fatalf("expected to read base4 just fine, but actually got error %s", err.Error())
+	}
+	var data map[string]any
+	json.Unmarshal(content, &data)
+	return data
+}
+
+func parseMapJSON(t *testing.T, data map[string]any) error {
+	content, _ := json.Marshal(data)
+	_, err := coreMaps.ParseMap(content)
+	return err
+}
+
+func TestValidateMap(t *testing.T) {
+	t.Run("validate map - base4", func(t *testing.T) {
+		err := parseMapJSON(t, readBase4(t))
+		if err != nil {
+			t.Errorf("expected base4 to be valid, but actually got error %s", err.Error())
+		}
+	})
+
+	t.Run("validate map - token count", func(t *testing.T) {
+		data := readBase4(t)
+		definitions := data["data"].(map[string]any)
+		definitions["tokens"] = definitions["tokens"].([]any)[1:]
+		err := parseMapJSON(t, data)
+		if err == nil || !strings.Contains(err.Error(), "17 tokens for 18 non desert tiles") {
+			t.Errorf("expected to have error since a token is missing, actually got %v", err)
+		}
+	})
+
+	t.Run("validate map - port off the coast", func(t *testing.T) {
+		data := readBase4(t)
+		definitions := data["data"].(map[string]any)
+		definitions["portsLocations"].([]any)[0] = []int{3, 4}
+		err := parseMapJSON(t, data)
+		if err == nil || !strings.Contains(err.Error(), "port at [3 4] isn't on the coast") {
+			t.Errorf("expected to have error since port is inland, actually got %v", err)
+		}
+	})
+
+	t.Run("validate map - resources count", func(t *testing.T) {
+		data := readBase4(t)
+		definitions := data["data"].(map[string]any)
+		definitions["resources"].([]any)[0].(map[string]any)["count"] = 4
+		err := parseMapJSON(t, data)
+		if err == nil || !strings.Contains(err.Error(), "resources sum up to 20 tiles, but the map has 19") {
+			t.Errorf("expected to have error since there are too many resources, actually got %v", err)
+		}
+	})
+
+	t.Run("validate map - asymmetric tables", func(t *testing.T) {
+		coreMaps.LoadMap("base4")
+		base4, _ := coreMaps.GetMapDefinitions("base4")
+		definitions := *base4
+		definitions.TilesByVertex = map[int][]int{}
+		for vertexID, tiles := range base4.TilesByVertex {
+			definitions.TilesByVertex[vertexID] = tiles
+		}
+		definitions.TilesByVertex[1] = []int{0}
+		err := coreMaps.Validate(&definitions)
+		if err == nil || !strings.Contains(err.Error(), "vertex#1 is in tile#4 but tilesByVertex doesn't list it") {
+			t.Errorf("expected to have error since vertex#1 misses tile#4, actually got %v", err)
+		}
+	})
+
+	t.Run("validate map - param default", func(t *testing.T) {
+		data := readBase4(t)
+		params := data["meta"].(map[string]any)["params"].(map[string]any)
+		params["speed"].(map[string]any)["default"] = 61
+		err := parseMapJSON(t, data)
+		if err == nil || !strings.Contains(err.Error(), "param speed default 61 isn't one of its values") {
+			t.Errorf("expected to have error since default isn't a value, actually got %v", err)
+		}
+	})
+}
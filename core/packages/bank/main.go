@@ -0,0 +1,61 @@
+package bank
+
+import (
+	"fmt"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Number of cards of each resource when the map doesn't say otherwise
+const DefaultSupply = 19
+
+type Instance struct {
+	supply    int
+	resources coreT.Resources
+}
+
+func New(supply int) *Instance {
+	if supply <= 0 {
+		supply = DefaultSupply
+	}
+	return &Instance{
+		supply: supply,
+		resources: coreT.Resources{
+			Lumber: supply,
+			Brick:  supply,
+			Sheep:  supply,
+			Grain:  supply,
+			Ore:    supply,
+		},
+	}
+}
+
+func (b *Instance) Available(resource coreT.Resource) int {
+	return b.resources.Get(resource)
+}
+
+// Has tells whether the bank can pay every resource of requested at once
+func (b *Instance) Has(requested coreT.Resources) bool {
+	return b.resources.Covers(requested)
+}
+
+func (b *Instance) Take(resources coreT.Resources) error {
+	if !b.resources.Covers(resources) {
+		err := fmt.Errorf("Bank has only %s, cannot give %s", b.resources, resources)
+		return err
+	}
+	b.resources = b.resources.Subtract(resources)
+	return nil
+}
+
+func (b *Instance) Return(resources coreT.Resources) {
+	b.resources = b.resources.Add(resources)
+}
+
+func (b *Instance) GetResources() coreT.Resources {
+	return b.resources
+}
+
+func (b *Instance) GetSupply() int {
+	return b.supply
+}
@@ -0,0 +1,27 @@
+package bank
+
+import coreT "github.com/victoroliveirab/settlers/core/types"
+
+type Snapshot struct {
+	Supply    int             `json:"supply"`
+	Resources coreT.Resources `json:"resources"`
+}
+
+func (b *Instance) Snapshot() Snapshot {
+	return Snapshot{
+		Supply:    b.supply,
+		Resources: b.resources,
+	}
+}
+
+func FromSnapshot(snapshot Snapshot) *Instance {
+	return &Instance{
+		supply:    snapshot.Supply,
+		resources: snapshot.Resources,
+	}
+}
+
+func (b *Instance) Clone() *Instance {
+	clone := *b
+	return &clone
+}
@@ -16,28 +16,54 @@ type Building struct {
 
 type Instance struct {
 	cities         map[int]Building
+	code           string
 	Definition     *coreMaps.MapDefinition
 	MapName        string
 	Ports          map[int]string
 	roads          map[int]Building
 	RobberLocation int
+	seaTiles       []coreT.MapBlock
 	settlements    map[int]Building
+	ships          map[int]Building
 	tiles          []coreT.MapBlock
 }
 
-func New(mapName string, definitions *coreMaps.MapDefinition, randGenerator *rand.Rand) *Instance {
-	data := coreMaps.GenerateMap(definitions, randGenerator)
-	b := &Instance{
+// New generates a board for mapName following layout. With balance set, shuffled boards follow its constraints
+func New(mapName string, definitions *coreMaps.MapDefinition, randGenerator *rand.Rand, layout coreMaps.Layout, balance *coreMaps.BalanceConstraints) (*Instance, error) {
+	data, err := coreMaps.Generate(definitions, randGenerator, layout, balance)
+	if err != nil {
+		return nil, err
+	}
+	code, err := coreMaps.Encode(definitions, data)
+	if err != nil {
+		return nil, err
+	}
+	return fromGenerated(mapName, definitions, data, code), nil
+}
+
+// NewFromCode lays out the board a previous game shared through its code
+func NewFromCode(mapName string, definitions *coreMaps.MapDefinition, code string) (*Instance, error) {
+	data, err := coreMaps.Decode(definitions, code)
+	if err != nil {
+		return nil, err
+	}
+	return fromGenerated(mapName, definitions, data, code), nil
+}
+
+func fromGenerated(mapName string, definitions *coreMaps.MapDefinition, data *coreMaps.GeneratedMap, code string) *Instance {
+	return &Instance{
 		cities:         make(map[int]Building),
+		code:           code,
 		Definition:     definitions,
 		MapName:        mapName,
 		Ports:          data.Ports,
 		roads:          make(map[int]Building),
 		RobberLocation: data.RobberPosition,
+		seaTiles:       coreMaps.SeaBlocks(definitions),
 		settlements:    make(map[int]Building),
+		ships:          make(map[int]Building),
 		tiles:          data.Tiles,
 	}
-	return b
 }
 
 func (b *Instance) AddCity(playerID string, vertexID int) {
@@ -49,6 +75,14 @@ func (b *Instance) AddRoad(playerID string, edgeID int) {
 	b.roads[edgeID] = Building{Owner: playerID, ID: edgeID}
 }
 
+func (b *Instance) AddShip(playerID string, edgeID int) {
+	b.ships[edgeID] = Building{Owner: playerID, ID: edgeID}
+}
+
+func (b *Instance) RemoveShip(edgeID int) {
+	delete(b.ships, edgeID)
+}
+
 func (b *Instance) AddSettlement(playerID string, vertexID int) {
 	b.settlements[vertexID] = Building{Owner: playerID, ID: vertexID}
 }
@@ -65,6 +99,21 @@ func (b *Instance) GetSettlements() map[int]Building {
 	return maps.Clone(b.settlements)
 }
 
+func (b *Instance) GetShips() map[int]Building {
+	return maps.Clone(b.ships)
+}
+
+func (b *Instance) GetSeaTiles() []coreT.MapBlock {
+	tiles := make([]coreT.MapBlock, len(b.seaTiles))
+	copy(tiles, b.seaTiles)
+	return tiles
+}
+
+// GetCode is the board code to play this board again, empty for boards set up by hand
+func (b *Instance) GetCode() string {
+	return b.code
+}
+
 func (b *Instance) GetTiles() []coreT.MapBlock {
 	tiles := make([]coreT.MapBlock, len(b.tiles))
 	copy(tiles, b.tiles)
@@ -138,3 +187,38 @@ func (b *Instance) UnblockTile(tileID int) error {
 	err := fmt.Errorf("Cannot unblock tile#%d: tile not found", tileID)
 	return err
 }
+
+func (b *Instance) HideTileByIndex(index int) {
+	b.tiles[index].Hidden = true
+}
+
+func (b *Instance) RevealTileByIndex(index int) error {
+	if !b.tiles[index].Hidden {
+		err := fmt.Errorf("Cannot reveal tile at index %d: not hidden", index)
+		return err
+	}
+	b.tiles[index].Hidden = false
+	return nil
+}
+
+func (b *Instance) GetHiddenTilesIDs() []int {
+	tileIDs := make([]int, 0)
+	for _, tile := range b.tiles {
+		if tile.Hidden {
+			tileIDs = append(tileIDs, tile.ID)
+		}
+	}
+	return tileIDs
+}
+
+// GetVisibleTiles works like GetTiles, but hidden tiles have neither resource nor token
+func (b *Instance) GetVisibleTiles() []coreT.MapBlock {
+	tiles := b.GetTiles()
+	for i := range tiles {
+		if tiles[i].Hidden {
+			tiles[i].Resource = coreMaps.HiddenResource
+			tiles[i].Token = 0
+		}
+	}
+	return tiles
+}
@@ -0,0 +1,80 @@
+package board
+
+import (
+	"maps"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+type Snapshot struct {
+	Cities         map[int]Building `json:"cities"`
+	Code           string           `json:"code,omitempty"`
+	MapName        string           `json:"mapName"`
+	Ports          map[int]string   `json:"ports"`
+	Roads          map[int]Building `json:"roads"`
+	RobberLocation int              `json:"robberLocation"`
+	Settlements    map[int]Building `json:"settlements"`
+	Ships          map[int]Building `json:"ships,omitempty"`
+	Tiles          []coreT.MapBlock `json:"tiles"`
+}
+
+func (b *Instance) Snapshot() Snapshot {
+	return Snapshot{
+		Cities:         maps.Clone(b.cities),
+		Code:           b.code,
+		MapName:        b.MapName,
+		Ports:          maps.Clone(b.Ports),
+		Roads:          maps.Clone(b.roads),
+		RobberLocation: b.RobberLocation,
+		Settlements:    maps.Clone(b.settlements),
+		Ships:          maps.Clone(b.ships),
+		Tiles:          b.GetTiles(),
+	}
+}
+
+func FromSnapshot(definitions *coreMaps.MapDefinition, snapshot Snapshot) *Instance {
+	b := &Instance{
+		cities:         maps.Clone(snapshot.Cities),
+		code:           snapshot.Code,
+		Definition:     definitions,
+		MapName:        snapshot.MapName,
+		Ports:          maps.Clone(snapshot.Ports),
+		roads:          maps.Clone(snapshot.Roads),
+		RobberLocation: snapshot.RobberLocation,
+		seaTiles:       coreMaps.SeaBlocks(definitions),
+		settlements:    maps.Clone(snapshot.Settlements),
+		ships:          maps.Clone(snapshot.Ships),
+		tiles:          make([]coreT.MapBlock, len(snapshot.Tiles)),
+	}
+	copy(b.tiles, snapshot.Tiles)
+	// maps.Clone returns nil for nil maps, which would make the board unwritable
+	if b.cities == nil {
+		b.cities = make(map[int]Building)
+	}
+	if b.roads == nil {
+		b.roads = make(map[int]Building)
+	}
+	if b.settlements == nil {
+		b.settlements = make(map[int]Building)
+	}
+	if b.ships == nil {
+		b.ships = make(map[int]Building)
+	}
+	if b.Ports == nil {
+		b.Ports = make(map[int]string)
+	}
+	return b
+}
+
+// Clone copies the buildings and tiles. The definitions and sea tiles never change, so they are shared
+func (b *Instance) Clone() *Instance {
+	clone := *b
+	clone.cities = maps.Clone(b.cities)
+	clone.Ports = maps.Clone(b.Ports)
+	clone.roads = maps.Clone(b.roads)
+	clone.settlements = maps.Clone(b.settlements)
+	clone.ships = maps.Clone(b.ships)
+	clone.tiles = b.GetTiles()
+	return &clone
+}
@@ -9,24 +9,24 @@ import (
 type Instance struct {
 	dice                         map[int]int
 	diceByPlayer                 map[string]map[int]int
+	diceMode                     string
 	longestRoadEvolutionPerRound map[string][]int
 	numberOfRobberiesByPlayer    map[string]int
 	numberOfTimesRobbedByPlayer  map[string]int
-	resourcesDiscardedByPlayer   map[string]map[string]int
-	resourcesDrawnByPlayer       map[string]map[string]int
-	resourcesBlockedByPlayer     map[string]map[string]int
-	resourcesUsedByPlayer        map[string]map[string]int
-	devCardsDrawnByPlayer        map[string]map[string]int
-	pointsEvolutionPerRound      map[string][]int
-	tradesByPlayer               map[string]map[string]int
-}
-
-var resources map[string]int = map[string]int{
-	"Lumber": 0,
-	"Brick":  0,
-	"Sheep":  0,
-	"Grain":  0,
-	"Ore":    0,
+	resourcesDiscardedByPlayer   map[string]coreT.Resources
+	resourcesDrawnByPlayer       map[string]coreT.Resources
+	// Resources picked from the bank for gold fields, kept apart from the ones drawn
+	resourcesFromGoldByPlayer map[string]coreT.Resources
+	// Resources handed out for exploring tiles in fog-of-war games
+	resourcesFromExploringByPlayer map[string]coreT.Resources
+	resourcesBlockedByPlayer       map[string]coreT.Resources
+	resourcesUsedByPlayer          map[string]coreT.Resources
+	// Resources given and received in bank and port trades
+	resourcesGivenToBankByPlayer      map[string]coreT.Resources
+	resourcesReceivedFromBankByPlayer map[string]coreT.Resources
+	devCardsDrawnByPlayer             map[string]map[string]int
+	pointsEvolutionPerRound           map[string][]int
+	tradesByPlayer                    map[string]map[string]int
 }
 
 func New(players []*coreT.Player) *Instance {
@@ -45,11 +45,15 @@ func New(players []*coreT.Player) *Instance {
 	}
 	numberOfRobberiesByPlayer := make(map[string]int)
 	numberOfTimesRobbedByPlayer := make(map[string]int)
-	resourcesBlockedByPlayer := make(map[string]map[string]int)
-	resourcesUsedByPlayer := make(map[string]map[string]int)
+	resourcesBlockedByPlayer := make(map[string]coreT.Resources)
+	resourcesUsedByPlayer := make(map[string]coreT.Resources)
+	resourcesGivenToBankByPlayer := make(map[string]coreT.Resources)
+	resourcesReceivedFromBankByPlayer := make(map[string]coreT.Resources)
 	diceStatsByPlayer := make(map[string]map[int]int)
-	resourcesDiscardedByPlayer := make(map[string]map[string]int)
-	resourcesDrawnByPlayer := make(map[string]map[string]int)
+	resourcesDiscardedByPlayer := make(map[string]coreT.Resources)
+	resourcesDrawnByPlayer := make(map[string]coreT.Resources)
+	resourcesFromGoldByPlayer := make(map[string]coreT.Resources)
+	resourcesFromExploringByPlayer := make(map[string]coreT.Resources)
 	devCardsDrawnByPlayer := make(map[string]map[string]int)
 	longestRoadEvolutionPerRound := make(map[string][]int)
 	pointsPerRound := make(map[string][]int)
@@ -59,11 +63,15 @@ func New(players []*coreT.Player) *Instance {
 		playerID := player.ID
 		numberOfRobberiesByPlayer[playerID] = 0
 		numberOfTimesRobbedByPlayer[playerID] = 0
-		resourcesBlockedByPlayer[playerID] = maps.Clone(resources)
+		resourcesBlockedByPlayer[playerID] = coreT.Resources{}
 		diceStatsByPlayer[playerID] = maps.Clone(dice)
-		resourcesDiscardedByPlayer[playerID] = maps.Clone(resources)
-		resourcesDrawnByPlayer[playerID] = maps.Clone(resources)
-		resourcesUsedByPlayer[playerID] = maps.Clone(resources)
+		resourcesDiscardedByPlayer[playerID] = coreT.Resources{}
+		resourcesDrawnByPlayer[playerID] = coreT.Resources{}
+		resourcesFromGoldByPlayer[playerID] = coreT.Resources{}
+		resourcesFromExploringByPlayer[playerID] = coreT.Resources{}
+		resourcesUsedByPlayer[playerID] = coreT.Resources{}
+		resourcesGivenToBankByPlayer[playerID] = coreT.Resources{}
+		resourcesReceivedFromBankByPlayer[playerID] = coreT.Resources{}
 		devCardsDrawnByPlayer[playerID] = map[string]int{
 			"Knight":         0,
 			"Victory Point":  0,
@@ -82,21 +90,29 @@ func New(players []*coreT.Player) *Instance {
 	}
 
 	return &Instance{
-		devCardsDrawnByPlayer:        devCardsDrawnByPlayer,
-		dice:                         dice,
-		diceByPlayer:                 diceStatsByPlayer,
-		longestRoadEvolutionPerRound: longestRoadEvolutionPerRound,
-		numberOfRobberiesByPlayer:    numberOfRobberiesByPlayer,
-		numberOfTimesRobbedByPlayer:  numberOfTimesRobbedByPlayer,
-		pointsEvolutionPerRound:      pointsPerRound,
-		resourcesBlockedByPlayer:     resourcesBlockedByPlayer,
-		resourcesDiscardedByPlayer:   resourcesDiscardedByPlayer,
-		resourcesDrawnByPlayer:       resourcesDrawnByPlayer,
-		resourcesUsedByPlayer:        resourcesUsedByPlayer,
-		tradesByPlayer:               tradesByPlayer,
+		devCardsDrawnByPlayer:             devCardsDrawnByPlayer,
+		dice:                              dice,
+		diceByPlayer:                      diceStatsByPlayer,
+		longestRoadEvolutionPerRound:      longestRoadEvolutionPerRound,
+		numberOfRobberiesByPlayer:         numberOfRobberiesByPlayer,
+		numberOfTimesRobbedByPlayer:       numberOfTimesRobbedByPlayer,
+		pointsEvolutionPerRound:           pointsPerRound,
+		resourcesBlockedByPlayer:          resourcesBlockedByPlayer,
+		resourcesDiscardedByPlayer:        resourcesDiscardedByPlayer,
+		resourcesDrawnByPlayer:            resourcesDrawnByPlayer,
+		resourcesFromGoldByPlayer:         resourcesFromGoldByPlayer,
+		resourcesFromExploringByPlayer:    resourcesFromExploringByPlayer,
+		resourcesUsedByPlayer:             resourcesUsedByPlayer,
+		resourcesGivenToBankByPlayer:      resourcesGivenToBankByPlayer,
+		resourcesReceivedFromBankByPlayer: resourcesReceivedFromBankByPlayer,
+		tradesByPlayer:                    tradesByPlayer,
 	}
 }
 
+func (s *Instance) SetDiceMode(mode string) {
+	s.diceMode = mode
+}
+
 func (s *Instance) AddDiceEntry(playerID string, sum int) {
 	s.dice[sum]++
 	s.diceByPlayer[playerID][sum]++
@@ -108,21 +124,34 @@ func (s *Instance) AddLongestRoadRecord(longestRoads map[string]int) {
 	}
 }
 
-func (s *Instance) AddResourceDiscarded(playerID, resource string, quantity int) {
-	s.resourcesDiscardedByPlayer[playerID][resource] += quantity
+func (s *Instance) AddResourcesDiscarded(playerID string, resources coreT.Resources) {
+	s.resourcesDiscardedByPlayer[playerID] = s.resourcesDiscardedByPlayer[playerID].Add(resources)
+}
+
+func (s *Instance) AddResourcesDrawn(playerID string, resources coreT.Resources) {
+	s.resourcesDrawnByPlayer[playerID] = s.resourcesDrawnByPlayer[playerID].Add(resources)
 }
 
-func (s *Instance) AddResourceDrawn(playerID, resource string, quantity int) {
-	s.resourcesDrawnByPlayer[playerID][resource] += quantity
+func (s *Instance) AddResourcesFromGold(playerID string, resources coreT.Resources) {
+	s.resourcesFromGoldByPlayer[playerID] = s.resourcesFromGoldByPlayer[playerID].Add(resources)
+}
+
+func (s *Instance) AddResourcesFromExploring(playerID string, resources coreT.Resources) {
+	s.resourcesFromExploringByPlayer[playerID] = s.resourcesFromExploringByPlayer[playerID].Add(resources)
 }
 
 // TODO: add "blockedBy" to the mix
-func (s *Instance) AddResourcesBlocked(playerID, resource string, quantity int) {
-	s.resourcesBlockedByPlayer[playerID][resource] += quantity
+func (s *Instance) AddResourcesBlocked(playerID string, resources coreT.Resources) {
+	s.resourcesBlockedByPlayer[playerID] = s.resourcesBlockedByPlayer[playerID].Add(resources)
+}
+
+func (s *Instance) AddResourcesUsed(playerID string, resources coreT.Resources) {
+	s.resourcesUsedByPlayer[playerID] = s.resourcesUsedByPlayer[playerID].Add(resources)
 }
 
-func (s *Instance) AddResourcesUsed(playerID, resource string, quantity int) {
-	s.resourcesUsedByPlayer[playerID][resource] += quantity
+func (s *Instance) AddResourcesTradedWithBank(playerID string, given, received coreT.Resources) {
+	s.resourcesGivenToBankByPlayer[playerID] = s.resourcesGivenToBankByPlayer[playerID].Add(given)
+	s.resourcesReceivedFromBankByPlayer[playerID] = s.resourcesReceivedFromBankByPlayer[playerID].Add(received)
 }
 
 func (s *Instance) AddDevCardDrawn(playerID, devCard string) {
@@ -155,6 +184,10 @@ func (s *Instance) GetDiceHistory() map[int]int {
 	return maps.Clone(s.dice)
 }
 
+func (s *Instance) GetDiceMode() string {
+	return s.diceMode
+}
+
 func (s *Instance) GetDiceHistoryByPlayer() map[string]map[int]int {
 	return maps.Clone(s.diceByPlayer)
 }
@@ -171,14 +204,38 @@ func (s *Instance) GetNumberOfTimesRobbedByPlayer() map[string]int {
 	return maps.Clone(s.numberOfTimesRobbedByPlayer)
 }
 
-func (s *Instance) GetResourcesBlockedByPlayer() map[string]map[string]int {
+func (s *Instance) GetResourcesBlockedByPlayer() map[string]coreT.Resources {
 	return maps.Clone(s.resourcesBlockedByPlayer)
 }
 
-func (s *Instance) GetResourcesUsedByPlayer() map[string]map[string]int {
+func (s *Instance) GetResourcesUsedByPlayer() map[string]coreT.Resources {
 	return maps.Clone(s.resourcesUsedByPlayer)
 }
 
+func (s *Instance) GetResourcesDrawnByPlayer() map[string]coreT.Resources {
+	return maps.Clone(s.resourcesDrawnByPlayer)
+}
+
+func (s *Instance) GetResourcesFromGoldByPlayer() map[string]coreT.Resources {
+	return maps.Clone(s.resourcesFromGoldByPlayer)
+}
+
+func (s *Instance) GetResourcesFromExploringByPlayer() map[string]coreT.Resources {
+	return maps.Clone(s.resourcesFromExploringByPlayer)
+}
+
+func (s *Instance) GetResourcesDiscardedByPlayer() map[string]coreT.Resources {
+	return maps.Clone(s.resourcesDiscardedByPlayer)
+}
+
+func (s *Instance) GetResourcesGivenToBankByPlayer() map[string]coreT.Resources {
+	return maps.Clone(s.resourcesGivenToBankByPlayer)
+}
+
+func (s *Instance) GetResourcesReceivedFromBankByPlayer() map[string]coreT.Resources {
+	return maps.Clone(s.resourcesReceivedFromBankByPlayer)
+}
+
 func (s *Instance) GetDevCardsDrawnByPlayer() map[string]map[string]int {
 	return maps.Clone(s.devCardsDrawnByPlayer)
 }
@@ -0,0 +1,127 @@
+package bookkeeping
+
+import (
+	"maps"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+type Snapshot struct {
+	Dice                              map[int]int                `json:"dice"`
+	DiceByPlayer                      map[string]map[int]int     `json:"diceByPlayer"`
+	DiceMode                          string                     `json:"diceMode,omitempty"`
+	LongestRoadEvolutionPerRound      map[string][]int           `json:"longestRoadEvolutionPerRound"`
+	NumberOfRobberiesByPlayer         map[string]int             `json:"numberOfRobberiesByPlayer"`
+	NumberOfTimesRobbedByPlayer       map[string]int             `json:"numberOfTimesRobbedByPlayer"`
+	ResourcesDiscardedByPlayer        map[string]coreT.Resources `json:"resourcesDiscardedByPlayer"`
+	ResourcesDrawnByPlayer            map[string]coreT.Resources `json:"resourcesDrawnByPlayer"`
+	ResourcesFromGoldByPlayer         map[string]coreT.Resources `json:"resourcesFromGoldByPlayer,omitempty"`
+	ResourcesFromExploringByPlayer    map[string]coreT.Resources `json:"resourcesFromExploringByPlayer,omitempty"`
+	ResourcesBlockedByPlayer          map[string]coreT.Resources `json:"resourcesBlockedByPlayer"`
+	ResourcesUsedByPlayer             map[string]coreT.Resources `json:"resourcesUsedByPlayer"`
+	ResourcesGivenToBankByPlayer      map[string]coreT.Resources `json:"resourcesGivenToBankByPlayer"`
+	ResourcesReceivedFromBankByPlayer map[string]coreT.Resources `json:"resourcesReceivedFromBankByPlayer"`
+	DevCardsDrawnByPlayer             map[string]map[string]int  `json:"devCardsDrawnByPlayer"`
+	PointsEvolutionPerRound           map[string][]int           `json:"pointsEvolutionPerRound"`
+	TradesByPlayer                    map[string]map[string]int  `json:"tradesByPlayer"`
+}
+
+func (s *Instance) Snapshot() Snapshot {
+	return Snapshot{
+		Dice:                              cloneMap(s.dice),
+		DiceByPlayer:                      cloneNestedMap(s.diceByPlayer),
+		DiceMode:                          s.diceMode,
+		LongestRoadEvolutionPerRound:      cloneSliceMap(s.longestRoadEvolutionPerRound),
+		NumberOfRobberiesByPlayer:         cloneMap(s.numberOfRobberiesByPlayer),
+		NumberOfTimesRobbedByPlayer:       cloneMap(s.numberOfTimesRobbedByPlayer),
+		ResourcesDiscardedByPlayer:        cloneMap(s.resourcesDiscardedByPlayer),
+		ResourcesDrawnByPlayer:            cloneMap(s.resourcesDrawnByPlayer),
+		ResourcesFromGoldByPlayer:         cloneMap(s.resourcesFromGoldByPlayer),
+		ResourcesFromExploringByPlayer:    cloneMap(s.resourcesFromExploringByPlayer),
+		ResourcesBlockedByPlayer:          cloneMap(s.resourcesBlockedByPlayer),
+		ResourcesUsedByPlayer:             cloneMap(s.resourcesUsedByPlayer),
+		ResourcesGivenToBankByPlayer:      cloneMap(s.resourcesGivenToBankByPlayer),
+		ResourcesReceivedFromBankByPlayer: cloneMap(s.resourcesReceivedFromBankByPlayer),
+		DevCardsDrawnByPlayer:             cloneNestedMap(s.devCardsDrawnByPlayer),
+		PointsEvolutionPerRound:           cloneSliceMap(s.pointsEvolutionPerRound),
+		TradesByPlayer:                    cloneNestedMap(s.tradesByPlayer),
+	}
+}
+
+func FromSnapshot(snapshot Snapshot) *Instance {
+	return &Instance{
+		dice:                              cloneMap(snapshot.Dice),
+		diceByPlayer:                      cloneNestedMap(snapshot.DiceByPlayer),
+		diceMode:                          snapshot.DiceMode,
+		longestRoadEvolutionPerRound:      cloneSliceMap(snapshot.LongestRoadEvolutionPerRound),
+		numberOfRobberiesByPlayer:         cloneMap(snapshot.NumberOfRobberiesByPlayer),
+		numberOfTimesRobbedByPlayer:       cloneMap(snapshot.NumberOfTimesRobbedByPlayer),
+		resourcesDiscardedByPlayer:        cloneMap(snapshot.ResourcesDiscardedByPlayer),
+		resourcesDrawnByPlayer:            cloneMap(snapshot.ResourcesDrawnByPlayer),
+		resourcesFromGoldByPlayer:         cloneMap(snapshot.ResourcesFromGoldByPlayer),
+		resourcesFromExploringByPlayer:    cloneMap(snapshot.ResourcesFromExploringByPlayer),
+		resourcesBlockedByPlayer:          cloneMap(snapshot.ResourcesBlockedByPlayer),
+		resourcesUsedByPlayer:             cloneMap(snapshot.ResourcesUsedByPlayer),
+		resourcesGivenToBankByPlayer:      cloneMap(snapshot.ResourcesGivenToBankByPlayer),
+		resourcesReceivedFromBankByPlayer: cloneMap(snapshot.ResourcesReceivedFromBankByPlayer),
+		devCardsDrawnByPlayer:             cloneNestedMap(snapshot.DevCardsDrawnByPlayer),
+		pointsEvolutionPerRound:           cloneSliceMap(snapshot.PointsEvolutionPerRound),
+		tradesByPlayer:                    cloneNestedMap(snapshot.TradesByPlayer),
+	}
+}
+
+// cloneMap is maps.Clone, but never returns nil so the copy can always be written to
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return make(map[K]V)
+	}
+	return maps.Clone(m)
+}
+
+func cloneNestedMap[K1 comparable, K2 comparable, V any](m map[K1]map[K2]V) map[K1]map[K2]V {
+	clone := make(map[K1]map[K2]V, len(m))
+	for key, value := range m {
+		clone[key] = cloneMap(value)
+	}
+	return clone
+}
+
+func cloneSliceMap[K comparable, V any](m map[K][]V) map[K][]V {
+	clone := make(map[K][]V, len(m))
+	for key, value := range m {
+		clone[key] = append(make([]V, 0, len(value)), value...)
+	}
+	return clone
+}
+
+func (s *Instance) Clone() *Instance {
+	return &Instance{
+		dice:                              cloneMap(s.dice),
+		diceByPlayer:                      cloneNestedMap(s.diceByPlayer),
+		diceMode:                          s.diceMode,
+		longestRoadEvolutionPerRound:      capSliceMap(s.longestRoadEvolutionPerRound),
+		numberOfRobberiesByPlayer:         cloneMap(s.numberOfRobberiesByPlayer),
+		numberOfTimesRobbedByPlayer:       cloneMap(s.numberOfTimesRobbedByPlayer),
+		resourcesDiscardedByPlayer:        cloneMap(s.resourcesDiscardedByPlayer),
+		resourcesDrawnByPlayer:            cloneMap(s.resourcesDrawnByPlayer),
+		resourcesFromGoldByPlayer:         cloneMap(s.resourcesFromGoldByPlayer),
+		resourcesFromExploringByPlayer:    cloneMap(s.resourcesFromExploringByPlayer),
+		resourcesBlockedByPlayer:          cloneMap(s.resourcesBlockedByPlayer),
+		resourcesUsedByPlayer:             cloneMap(s.resourcesUsedByPlayer),
+		resourcesGivenToBankByPlayer:      cloneMap(s.resourcesGivenToBankByPlayer),
+		resourcesReceivedFromBankByPlayer: cloneMap(s.resourcesReceivedFromBankByPlayer),
+		devCardsDrawnByPlayer:             cloneNestedMap(s.devCardsDrawnByPlayer),
+		pointsEvolutionPerRound:           capSliceMap(s.pointsEvolutionPerRound),
+		tradesByPlayer:                    cloneNestedMap(s.tradesByPlayer),
+	}
+}
+
+// capSliceMap shares the slices of m, capped so the next append on either side copies them.
+// Only fit for slices that are never written to but by appending
+func capSliceMap[K comparable, V any](m map[K][]V) map[K][]V {
+	clone := make(map[K][]V, len(m))
+	for key, value := range m {
+		clone[key] = value[:len(value):len(value)]
+	}
+	return clone
+}
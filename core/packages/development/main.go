@@ -34,3 +34,7 @@ func (d *Instance) IsEmpty() bool {
 func (d *Instance) Remaining() int {
 	return len(d.cards) - d.nextCardIndex
 }
+
+func (d *Instance) Size() int {
+	return len(d.cards)
+}
@@ -0,0 +1,50 @@
+package development
+
+import (
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+type Snapshot struct {
+	Cards         []coreT.DevelopmentCardSnapshot `json:"cards"`
+	NextCardIndex int                             `json:"nextCardIndex"`
+}
+
+func (d *Instance) Snapshot() Snapshot {
+	cards := make([]coreT.DevelopmentCardSnapshot, len(d.cards))
+	for i, card := range d.cards {
+		cards[i] = coreT.DevelopmentCardSnapshot{
+			Name:        card.Name,
+			RoundBought: card.RoundBought,
+		}
+	}
+	return Snapshot{
+		Cards:         cards,
+		NextCardIndex: d.nextCardIndex,
+	}
+}
+
+func FromSnapshot(snapshot Snapshot) *Instance {
+	cards := make([]*coreT.DevelopmentCard, len(snapshot.Cards))
+	for i, card := range snapshot.Cards {
+		cards[i] = &coreT.DevelopmentCard{
+			Name:        card.Name,
+			RoundBought: card.RoundBought,
+		}
+	}
+	return &Instance{
+		cards:         cards,
+		nextCardIndex: snapshot.NextCardIndex,
+	}
+}
+
+func (d *Instance) Clone() *Instance {
+	cards := make([]*coreT.DevelopmentCard, len(d.cards))
+	for i, card := range d.cards {
+		copied := *card
+		cards[i] = &copied
+	}
+	return &Instance{
+		cards:         cards,
+		nextCardIndex: d.nextCardIndex,
+	}
+}
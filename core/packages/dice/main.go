@@ -0,0 +1,213 @@
+package dice
+
+import (
+	"math/rand"
+	"slices"
+)
+
+type Mode int
+
+const (
+	// Two independent dice, as in the physical game
+	Classic Mode = iota
+	// A shuffled deck with one card per dice outcome, which makes the sums follow the 2 to 12 distribution
+	// exactly until it's reshuffled
+	EventDeck
+	// Random dice that make sums rolled more often than expected lately, and 7s of a player that has
+	// rolled many of them, less likely
+	Balanced
+)
+
+var ModeNames = map[Mode]string{
+	Classic:   "Classic",
+	EventDeck: "Event Deck",
+	Balanced:  "Balanced",
+}
+
+// Source hands out the dice of every roll. Sources draw from the game random generator, so they are passed it
+// on every roll rather than keeping one, and keep whatever else they need to be snapshotted
+type Source interface {
+	Mode() Mode
+	Roll(r *rand.Rand, playerID string) (int, int)
+	// RollExcluding is like Roll, but the dice never add up to excludedSum.
+	// Outcomes adding up to it are neither used up nor remembered
+	RollExcluding(r *rand.Rand, playerID string, excludedSum int) (int, int)
+	Snapshot() Snapshot
+	Clone() Source
+}
+
+// New returns the source for mode. reshuffleAt is the number of cards left in the event deck when it's
+// reshuffled, ignored by the other modes
+func New(mode Mode, reshuffleAt int) Source {
+	switch mode {
+	case EventDeck:
+		return &eventDeck{reshuffleAt: reshuffleAt}
+	case Balanced:
+		return &balanced{recent: make([]Roll, 0)}
+	default:
+		return &classic{}
+	}
+}
+
+// Ways lists how many of the 36 dice outcomes add up to each sum
+var Ways = map[int]int{2: 1, 3: 2, 4: 3, 5: 4, 6: 5, 7: 6, 8: 5, 9: 4, 10: 3, 11: 2, 12: 1}
+
+type classic struct{}
+
+func (c *classic) Mode() Mode {
+	return Classic
+}
+
+func (c *classic) Roll(r *rand.Rand, playerID string) (int, int) {
+	return c.RollExcluding(r, playerID, 0)
+}
+
+func (c *classic) RollExcluding(r *rand.Rand, playerID string, excludedSum int) (int, int) {
+	for {
+		dice1 := r.Intn(6) + 1
+		dice2 := r.Intn(6) + 1
+		if dice1+dice2 != excludedSum {
+			return dice1, dice2
+		}
+	}
+}
+
+func (c *classic) Snapshot() Snapshot {
+	return Snapshot{Mode: Classic}
+}
+
+type eventDeck struct {
+	cards       [][2]int
+	reshuffleAt int
+}
+
+func (d *eventDeck) Mode() Mode {
+	return EventDeck
+}
+
+func (d *eventDeck) Roll(r *rand.Rand, playerID string) (int, int) {
+	return d.RollExcluding(r, playerID, 0)
+}
+
+// RollExcluding draws the first card not adding up to excludedSum, leaving the ones before it on top of the deck.
+// If every card left adds up to it, the deck is reshuffled
+func (d *eventDeck) RollExcluding(r *rand.Rand, playerID string, excludedSum int) (int, int) {
+	// The deck is shuffled on the first roll, so creating the game draws nothing from the random generator
+	if len(d.cards) <= d.reshuffleAt {
+		d.shuffle(r)
+	}
+	allowed := func(card [2]int) bool { return card[0]+card[1] != excludedSum }
+	index := slices.IndexFunc(d.cards, allowed)
+	if index == -1 {
+		d.shuffle(r)
+		index = slices.IndexFunc(d.cards, allowed)
+	}
+	card := d.cards[index]
+	d.cards = append(d.cards[:index:index], d.cards[index+1:]...)
+	return card[0], card[1]
+}
+
+func (d *eventDeck) shuffle(r *rand.Rand) {
+	d.cards = make([][2]int, 0, 36)
+	for dice1 := 1; dice1 <= 6; dice1++ {
+		for dice2 := 1; dice2 <= 6; dice2++ {
+			d.cards = append(d.cards, [2]int{dice1, dice2})
+		}
+	}
+	r.Shuffle(len(d.cards), func(i, j int) {
+		d.cards[i], d.cards[j] = d.cards[j], d.cards[i]
+	})
+}
+
+func (d *eventDeck) Snapshot() Snapshot {
+	return Snapshot{
+		Mode:        EventDeck,
+		Cards:       append([][2]int{}, d.cards...),
+		ReshuffleAt: d.reshuffleAt,
+	}
+}
+
+type Roll struct {
+	PlayerID string `json:"playerID"`
+	Sum      int    `json:"sum"`
+}
+
+// Balanced dice only remember the latest rolls, so a streak eventually stops weighing on the odds
+const balancedMemory = 36
+
+type balanced struct {
+	recent []Roll
+}
+
+func (b *balanced) Mode() Mode {
+	return Balanced
+}
+
+func (b *balanced) Roll(r *rand.Rand, playerID string) (int, int) {
+	return b.RollExcluding(r, playerID, 0)
+}
+
+func (b *balanced) RollExcluding(r *rand.Rand, playerID string, excludedSum int) (int, int) {
+	weights := b.weights(playerID)
+	delete(weights, excludedSum)
+	total := 0.0
+	for sum := 2; sum <= 12; sum++ {
+		total += weights[sum]
+	}
+
+	pick := r.Float64() * total
+	sum := 12
+	for candidate := 2; candidate <= 12; candidate++ {
+		pick -= weights[candidate]
+		if pick < 0 {
+			sum = candidate
+			break
+		}
+	}
+
+	b.recent = append(b.recent, Roll{PlayerID: playerID, Sum: sum})
+	if len(b.recent) > balancedMemory {
+		b.recent = b.recent[1:]
+	}
+
+	// Any of the outcomes adding up to sum
+	dice1 := max(1, sum-6) + r.Intn(Ways[sum])
+	return dice1, sum - dice1
+}
+
+// weights starts from the odds of each sum, lowering the ones rolled more often than expected lately and raising
+// the ones rolled less often, then lowers 7 further when playerID has rolled more 7s than their share.
+// A sum's odds never drop below a fifth nor go over twice the regular ones
+func (b *balanced) weights(playerID string) map[int]float64 {
+	counts := make(map[int]int)
+	playerRolls := 0
+	playerSevens := 0
+	for _, entry := range b.recent {
+		counts[entry.Sum]++
+		if entry.PlayerID == playerID {
+			playerRolls++
+			if entry.Sum == 7 {
+				playerSevens++
+			}
+		}
+	}
+
+	weights := make(map[int]float64)
+	for sum, ways := range Ways {
+		expected := float64(ways*len(b.recent)) / 36
+		factor := 1 - 0.2*(float64(counts[sum])-expected)
+		if sum == 7 {
+			expectedSevens := float64(ways*playerRolls) / 36
+			factor -= 0.3 * (float64(playerSevens) - expectedSevens)
+		}
+		weights[sum] = float64(ways) * min(2, max(0.2, factor))
+	}
+	return weights
+}
+
+func (b *balanced) Snapshot() Snapshot {
+	return Snapshot{
+		Mode:   Balanced,
+		Recent: append([]Roll{}, b.recent...),
+	}
+}
@@ -0,0 +1,37 @@
+package dice
+
+type Snapshot struct {
+	Mode        Mode     `json:"mode"`
+	Cards       [][2]int `json:"cards,omitempty"`
+	ReshuffleAt int      `json:"reshuffleAt,omitempty"`
+	Recent      []Roll   `json:"recent,omitempty"`
+}
+
+func FromSnapshot(snapshot Snapshot) Source {
+	switch snapshot.Mode {
+	case EventDeck:
+		return &eventDeck{
+			cards:       append([][2]int{}, snapshot.Cards...),
+			reshuffleAt: snapshot.ReshuffleAt,
+		}
+	case Balanced:
+		return &balanced{recent: append([]Roll{}, snapshot.Recent...)}
+	default:
+		return &classic{}
+	}
+}
+
+func (c *classic) Clone() Source {
+	return &classic{}
+}
+
+func (d *eventDeck) Clone() Source {
+	return &eventDeck{
+		cards:       append([][2]int{}, d.cards...),
+		reshuffleAt: d.reshuffleAt,
+	}
+}
+
+func (b *balanced) Clone() Source {
+	return &balanced{recent: append([]Roll{}, b.recent...)}
+}
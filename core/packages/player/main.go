@@ -10,34 +10,38 @@ import (
 
 type Instance struct {
 	id                    string
-	resources             map[string]int
+	resources             coreT.Resources
 	developmentCards      map[string][]*coreT.DevelopmentCard
 	usedDevelopmentCards  map[string]int
 	settlements           []int
 	cities                []int
 	roads                 []int
+	ships                 []int
 	ports                 []int
 	portsTypes            []string
 	points                int
 	longestRoadSegments   []int
 	numDevCardsPlayedTurn int
+	hasMovedShipThisTurn  bool
 	discardAmount         int
+	goldPickAmount        int
 	hasDiscardedThisRound bool
 }
 
 func New(
 	player *coreT.Player,
-	initialResources map[string]int,
+	initialResources coreT.Resources,
 	initialDevCards map[string][]*coreT.DevelopmentCard,
 ) *Instance {
 	return &Instance{
 		id:                    player.ID,
-		resources:             maps.Clone(initialResources),
+		resources:             initialResources,
 		developmentCards:      maps.Clone(initialDevCards),
 		usedDevelopmentCards:  make(map[string]int),
 		settlements:           make([]int, 0),
 		cities:                make([]int, 0),
 		roads:                 make([]int, 0),
+		ships:                 make([]int, 0),
 		ports:                 make([]int, 0),
 		portsTypes:            make([]string, 0),
 		longestRoadSegments:   make([]int, 0),
@@ -48,15 +52,23 @@ func New(
 }
 
 func (p *Instance) HasResourcesToBuildCity() bool {
-	return p.resources["Grain"] >= 2 && p.resources["Ore"] >= 3
+	return p.resources.Covers(coreT.CityCost)
 }
 
-func (p *Instance) AddResource(resource string, quantity int) {
-	p.resources[resource] += quantity
+func (p *Instance) AddResource(resource coreT.Resource, quantity int) {
+	p.resources = p.resources.Add(coreT.ResourcesOf(resource, quantity))
 }
 
-func (p *Instance) RemoveResource(resource string, quantity int) {
-	p.resources[resource] -= quantity
+func (p *Instance) RemoveResource(resource coreT.Resource, quantity int) {
+	p.resources = p.resources.Subtract(coreT.ResourcesOf(resource, quantity))
+}
+
+func (p *Instance) AddResources(resources coreT.Resources) {
+	p.resources = p.resources.Add(resources)
+}
+
+func (p *Instance) RemoveResources(resources coreT.Resources) {
+	p.resources = p.resources.Subtract(resources)
 }
 
 func (p *Instance) AddDevelopmentCard(card *coreT.DevelopmentCard) {
@@ -118,6 +130,19 @@ func (p *Instance) AddRoad(edgeID int) {
 	p.roads = append(p.roads, edgeID)
 }
 
+func (p *Instance) AddShip(edgeID int) {
+	p.ships = append(p.ships, edgeID)
+}
+
+func (p *Instance) RemoveShip(edgeID int) {
+	for index, shipID := range p.ships {
+		if shipID == edgeID {
+			utils.SliceRemove(&p.ships, index)
+			return
+		}
+	}
+}
+
 func (p *Instance) AddPort(vertexID int, kind string) {
 	p.ports = append(p.ports, vertexID)
 	p.portsTypes = append(p.portsTypes, kind)
@@ -127,8 +152,8 @@ func (p *Instance) GetID() string {
 	return p.id
 }
 
-func (p *Instance) GetResources() map[string]int {
-	return maps.Clone(p.resources)
+func (p *Instance) GetResources() coreT.Resources {
+	return p.resources
 }
 
 func (p *Instance) GetDevelopmentCards() map[string][]*coreT.DevelopmentCard {
@@ -171,6 +196,14 @@ func (p *Instance) GetNumberOfRoads() int {
 	return len(p.roads)
 }
 
+func (p *Instance) GetShips() []int {
+	return p.ships
+}
+
+func (p *Instance) GetNumberOfShips() int {
+	return len(p.ships)
+}
+
 func (p *Instance) GetPortTypes() []string {
 	return p.portsTypes
 }
@@ -195,6 +228,14 @@ func (p *Instance) ResetNumberOfDevCardsPlayedCurrentTurn() {
 	p.numDevCardsPlayedTurn = 0
 }
 
+func (p *Instance) GetHasMovedShipThisTurn() bool {
+	return p.hasMovedShipThisTurn
+}
+
+func (p *Instance) SetHasMovedShipThisTurn(value bool) {
+	p.hasMovedShipThisTurn = value
+}
+
 func (p *Instance) GetDiscardAmount() int {
 	return p.discardAmount
 }
@@ -203,6 +244,14 @@ func (p *Instance) SetDiscardAmount(value int) {
 	p.discardAmount = value
 }
 
+func (p *Instance) GetGoldPickAmount() int {
+	return p.goldPickAmount
+}
+
+func (p *Instance) SetGoldPickAmount(value int) {
+	p.goldPickAmount = value
+}
+
 func (p *Instance) GetHasDiscardedThisTurn() bool {
 	return p.hasDiscardedThisRound
 }
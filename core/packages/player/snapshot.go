@@ -0,0 +1,124 @@
+package player
+
+import (
+	"maps"
+	"slices"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+type Snapshot struct {
+	ID                    string                                     `json:"id"`
+	Resources             coreT.Resources                            `json:"resources"`
+	DevelopmentCards      map[string][]coreT.DevelopmentCardSnapshot `json:"developmentCards"`
+	UsedDevelopmentCards  map[string]int                             `json:"usedDevelopmentCards"`
+	Settlements           []int                                      `json:"settlements"`
+	Cities                []int                                      `json:"cities"`
+	Roads                 []int                                      `json:"roads"`
+	Ships                 []int                                      `json:"ships,omitempty"`
+	Ports                 []int                                      `json:"ports"`
+	PortsTypes            []string                                   `json:"portsTypes"`
+	Points                int                                        `json:"points"`
+	LongestRoadSegments   []int                                      `json:"longestRoadSegments"`
+	NumDevCardsPlayedTurn int                                        `json:"numDevCardsPlayedTurn"`
+	HasMovedShipThisTurn  bool                                       `json:"hasMovedShipThisTurn,omitempty"`
+	DiscardAmount         int                                        `json:"discardAmount"`
+	GoldPickAmount        int                                        `json:"goldPickAmount,omitempty"`
+	HasDiscardedThisRound bool                                       `json:"hasDiscardedThisRound"`
+}
+
+func (p *Instance) Snapshot() Snapshot {
+	developmentCards := make(map[string][]coreT.DevelopmentCardSnapshot)
+	for name, cards := range p.developmentCards {
+		developmentCards[name] = make([]coreT.DevelopmentCardSnapshot, len(cards))
+		for i, card := range cards {
+			developmentCards[name][i] = coreT.DevelopmentCardSnapshot{
+				Name:        card.Name,
+				RoundBought: card.RoundBought,
+			}
+		}
+	}
+	return Snapshot{
+		ID:                    p.id,
+		Resources:             p.resources,
+		DevelopmentCards:      developmentCards,
+		UsedDevelopmentCards:  maps.Clone(p.usedDevelopmentCards),
+		Settlements:           slices.Clone(p.settlements),
+		Cities:                slices.Clone(p.cities),
+		Roads:                 slices.Clone(p.roads),
+		Ships:                 slices.Clone(p.ships),
+		Ports:                 slices.Clone(p.ports),
+		PortsTypes:            slices.Clone(p.portsTypes),
+		Points:                p.points,
+		LongestRoadSegments:   slices.Clone(p.longestRoadSegments),
+		NumDevCardsPlayedTurn: p.numDevCardsPlayedTurn,
+		HasMovedShipThisTurn:  p.hasMovedShipThisTurn,
+		DiscardAmount:         p.discardAmount,
+		GoldPickAmount:        p.goldPickAmount,
+		HasDiscardedThisRound: p.hasDiscardedThisRound,
+	}
+}
+
+func FromSnapshot(snapshot Snapshot) *Instance {
+	developmentCards := make(map[string][]*coreT.DevelopmentCard)
+	for name, cards := range snapshot.DevelopmentCards {
+		developmentCards[name] = make([]*coreT.DevelopmentCard, len(cards))
+		for i, card := range cards {
+			developmentCards[name][i] = &coreT.DevelopmentCard{
+				Name:        card.Name,
+				RoundBought: card.RoundBought,
+			}
+		}
+	}
+	usedDevelopmentCards := maps.Clone(snapshot.UsedDevelopmentCards)
+	if usedDevelopmentCards == nil {
+		usedDevelopmentCards = make(map[string]int)
+	}
+	return &Instance{
+		id:                    snapshot.ID,
+		resources:             snapshot.Resources,
+		developmentCards:      developmentCards,
+		usedDevelopmentCards:  usedDevelopmentCards,
+		settlements:           nonNilSlice(snapshot.Settlements),
+		cities:                nonNilSlice(snapshot.Cities),
+		roads:                 nonNilSlice(snapshot.Roads),
+		ships:                 nonNilSlice(snapshot.Ships),
+		ports:                 nonNilSlice(snapshot.Ports),
+		portsTypes:            nonNilSlice(snapshot.PortsTypes),
+		points:                snapshot.Points,
+		longestRoadSegments:   nonNilSlice(snapshot.LongestRoadSegments),
+		numDevCardsPlayedTurn: snapshot.NumDevCardsPlayedTurn,
+		hasMovedShipThisTurn:  snapshot.HasMovedShipThisTurn,
+		discardAmount:         snapshot.DiscardAmount,
+		goldPickAmount:        snapshot.GoldPickAmount,
+		hasDiscardedThisRound: snapshot.HasDiscardedThisRound,
+	}
+}
+
+func nonNilSlice[T any](slice []T) []T {
+	if slice == nil {
+		return make([]T, 0)
+	}
+	return slices.Clone(slice)
+}
+
+func (p *Instance) Clone() *Instance {
+	clone := *p
+	clone.developmentCards = make(map[string][]*coreT.DevelopmentCard, len(p.developmentCards))
+	for name, cards := range p.developmentCards {
+		clone.developmentCards[name] = make([]*coreT.DevelopmentCard, len(cards))
+		for i, card := range cards {
+			copied := *card
+			clone.developmentCards[name][i] = &copied
+		}
+	}
+	clone.usedDevelopmentCards = maps.Clone(p.usedDevelopmentCards)
+	clone.settlements = slices.Clone(p.settlements)
+	clone.cities = slices.Clone(p.cities)
+	clone.roads = slices.Clone(p.roads)
+	clone.ships = slices.Clone(p.ships)
+	clone.ports = slices.Clone(p.ports)
+	clone.portsTypes = slices.Clone(p.portsTypes)
+	clone.longestRoadSegments = slices.Clone(p.longestRoadSegments)
+	return &clone
+}
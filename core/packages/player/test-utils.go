@@ -6,7 +6,7 @@ import (
 	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
-func (p *Instance) SetResources(resources map[string]int) {
+func (p *Instance) SetResources(resources coreT.Resources) {
 	p.resources = resources
 }
 
@@ -19,9 +19,13 @@ const (
 	YearOfPlentyPickResources
 	DiscardPhase
 	GameOver
+	// 5-6 players: after a turn ends, each other player in order may build and buy, but not trade
+	SpecialBuild
+	// Players with buildings around a gold field whose token was rolled pick the resources they get
+	GoldPick
 )
 
-var RoundTypeTranslation = [16]string{
+var RoundTypeTranslation = [18]string{
 	"SettlementSetup#1",
 	"RoadSetup#1",
 	"SettlementSetup#2",
@@ -38,12 +42,16 @@ var RoundTypeTranslation = [16]string{
 	"YearOfPlentyPickResources",
 	"DiscardPhase",
 	"GameOver",
+	"SpecialBuild",
+	"GoldPick",
 }
 
 type Instance struct {
-	dice        [2]int
-	roundNumber int
-	roundType   Type
+	dice                [2]int
+	secondDice          [2]int
+	roundNumber         int
+	roundType           Type
+	specialBuildersLeft int
 }
 
 func New() *Instance {
@@ -58,6 +66,10 @@ func (r *Instance) GetDice() [2]int {
 	return r.dice
 }
 
+func (r *Instance) GetSecondDice() [2]int {
+	return r.secondDice
+}
+
 func (r *Instance) GetRoundType() Type {
 	return r.roundType
 }
@@ -79,6 +91,11 @@ func (r *Instance) SetDice(d1, d2 int) {
 	r.dice[1] = d2
 }
 
+func (r *Instance) SetSecondDice(d1, d2 int) {
+	r.secondDice[0] = d1
+	r.secondDice[1] = d2
+}
+
 func (r *Instance) SetRoundType(roundType Type) {
 	r.roundType = roundType
 }
@@ -86,3 +103,11 @@ func (r *Instance) SetRoundType(roundType Type) {
 func (r *Instance) IncrementRound() {
 	r.roundNumber++
 }
+
+func (r *Instance) GetSpecialBuildersLeft() int {
+	return r.specialBuildersLeft
+}
+
+func (r *Instance) SetSpecialBuildersLeft(builders int) {
+	r.specialBuildersLeft = builders
+}
@@ -0,0 +1,34 @@
+package round
+
+type Snapshot struct {
+	Dice                [2]int `json:"dice"`
+	SecondDice          [2]int `json:"secondDice"`
+	RoundNumber         int    `json:"roundNumber"`
+	RoundType           Type   `json:"roundType"`
+	SpecialBuildersLeft int    `json:"specialBuildersLeft,omitempty"`
+}
+
+func (r *Instance) Snapshot() Snapshot {
+	return Snapshot{
+		Dice:                r.dice,
+		SecondDice:          r.secondDice,
+		RoundNumber:         r.roundNumber,
+		RoundType:           r.roundType,
+		SpecialBuildersLeft: r.specialBuildersLeft,
+	}
+}
+
+func FromSnapshot(snapshot Snapshot) *Instance {
+	return &Instance{
+		dice:                snapshot.Dice,
+		secondDice:          snapshot.SecondDice,
+		roundNumber:         snapshot.RoundNumber,
+		roundType:           snapshot.RoundType,
+		specialBuildersLeft: snapshot.SpecialBuildersLeft,
+	}
+}
+
+func (r *Instance) Clone() *Instance {
+	clone := *r
+	return &clone
+}
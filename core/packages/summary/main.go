@@ -16,6 +16,7 @@ type PlayerPointDistribution struct {
 }
 
 type Statistics struct {
+	DiceMode                  string                 `json:"diceMode"`
 	GeneralDiceStats          map[int]int            `json:"generalDiceStats"`
 	DiceStatsByPlayer         map[string]map[int]int `json:"diceStatsByPlayer"`
 	LongestRoadEvolution      map[string][]int       `json:"longestRoadEvolution"`
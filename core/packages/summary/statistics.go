@@ -2,6 +2,7 @@ package summary
 
 func (s *Instance) getStatistics(input ReportInput) Statistics {
 	return Statistics{
+		DiceMode:                  s.bookKeeping.GetDiceMode(),
 		GeneralDiceStats:          s.bookKeeping.GetDiceHistory(),
 		DiceStatsByPlayer:         s.bookKeeping.GetDiceHistoryByPlayer(),
 		LongestRoadEvolution:      s.bookKeeping.GetLongestRoadEvolutionPerRound(),
@@ -4,18 +4,24 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/player"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 // TODO: register bank trade to the trade manager
 func (tm *Instance) MakeBankTrade(
 	playerState *player.Instance,
 	bankTradeCost int,
-	givenResources map[string]int,
-	requestedResources map[string]int,
+	givenResources coreT.Resources,
+	requestedResources coreT.Resources,
 ) error {
+	if err := validateResources(givenResources, requestedResources); err != nil {
+		return err
+	}
+
 	availableResourcesToRequest := 0
 	playerResources := playerState.GetResources()
-	for resource, quantity := range givenResources {
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := givenResources.Get(resource)
 		if quantity == 0 {
 			continue
 		}
@@ -23,16 +29,16 @@ func (tm *Instance) MakeBankTrade(
 			err := fmt.Errorf("Cannot trade %d of %s: not a multiple of %d", quantity, resource, bankTradeCost)
 			return err
 		}
-		if playerResources[resource] < quantity {
+		if playerResources.Get(resource) < quantity {
 			err := fmt.Errorf("Cannot trade %d of %s with bank: doesn't have that quantity available", quantity, resource)
 			return err
 		}
 		availableResourcesToRequest += quantity / bankTradeCost
 	}
 
-	for resource, quantity := range requestedResources {
-		givenQuantity, ok := givenResources[resource]
-		if ok && givenQuantity > 0 && quantity > 0 {
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := requestedResources.Get(resource)
+		if givenResources.Get(resource) > 0 && quantity > 0 {
 			err := fmt.Errorf("Cannot complete bank trade: giving and requesting %s", resource)
 			return err
 		}
@@ -42,11 +48,19 @@ func (tm *Instance) MakeBankTrade(
 		err := fmt.Errorf("Cannot complete bank trade: wrong proportion of given and requested resorces")
 		return err
 	}
-	for resource, quantity := range givenResources {
-		playerState.RemoveResource(resource, quantity)
-	}
-	for resource, quantity := range requestedResources {
-		playerState.AddResource(resource, quantity)
+
+	if !tm.bank.Has(requestedResources) {
+		err := fmt.Errorf("Cannot complete bank trade: bank doesn't have the requested resources")
+		return err
 	}
+	tm.exchangeWithBank(playerState, givenResources, requestedResources)
 	return nil
 }
+
+func (tm *Instance) exchangeWithBank(playerState *player.Instance, givenResources, requestedResources coreT.Resources) {
+	playerState.RemoveResources(givenResources)
+	tm.bank.Return(givenResources)
+	tm.bank.Take(requestedResources)
+	playerState.AddResources(requestedResources)
+	tm.bookKeeping.AddResourcesTradedWithBank(playerState.GetID(), givenResources, requestedResources)
+}
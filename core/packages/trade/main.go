@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/victoroliveirab/settlers/core/packages/bank"
 	"github.com/victoroliveirab/settlers/core/packages/book-keeping"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 type ResponseStatus string
@@ -34,8 +36,8 @@ type Trade struct {
 	Requester string                       `json:"requester"`
 	Creator   string                       `json:"creator"`
 	Responses map[string]*TradePlayerEntry `json:"responses"`
-	Offer     map[string]int               `json:"offer"`
-	Request   map[string]int               `json:"request"`
+	Offer     coreT.Resources              `json:"offer"`
+	Request   coreT.Resources              `json:"request"`
 	Status    TradeStatus                  `json:"status"`
 	ParentID  int                          `json:"parent"`
 	Finalized bool                         `json:"finalized"`
@@ -49,14 +51,16 @@ type Instance struct {
 	parentToChildMap map[int][]int
 	nextTradeID      int
 	bookKeeping      *bookkeeping.Instance
+	bank             *bank.Instance
 }
 
-func New(bookKeepingHandler *bookkeeping.Instance) *Instance {
+func New(bookKeepingHandler *bookkeeping.Instance, bankHandler *bank.Instance) *Instance {
 	return &Instance{
 		// activeTrades:     make(map[int]*Trade),
 		parentToChildMap: make(map[int][]int),
 		nextTradeID:      1,
 		bookKeeping:      bookKeepingHandler,
+		bank:             bankHandler,
 		trades:           make(map[int]*Trade),
 	}
 }
@@ -123,3 +127,15 @@ func (tm *Instance) CancelActiveTrades() {
 		}
 	}
 }
+
+func validateResources(givenResources, requestedResources coreT.Resources) error {
+	if err := givenResources.Validate(); err != nil {
+		err := fmt.Errorf("Cannot trade: %w", err)
+		return err
+	}
+	if err := requestedResources.Validate(); err != nil {
+		err := fmt.Errorf("Cannot trade: %w", err)
+		return err
+	}
+	return nil
+}
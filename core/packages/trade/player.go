@@ -11,15 +11,20 @@ import (
 
 func (tm *Instance) MakeTradeOffer(
 	playerState *player.Instance,
-	givenResources map[string]int,
-	requestedResources map[string]int,
+	givenResources coreT.Resources,
+	requestedResources coreT.Resources,
 	players []coreT.Player,
 	blockedPlayers []string,
 ) (int, error) {
+	if err := validateResources(givenResources, requestedResources); err != nil {
+		return -1, err
+	}
+
 	playerID := playerState.GetID()
 	ownedResources := playerState.GetResources()
-	for resource, quantity := range givenResources {
-		totalFromOfferedResource := ownedResources[resource]
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := givenResources.Get(resource)
+		totalFromOfferedResource := ownedResources.Get(resource)
 		if totalFromOfferedResource < quantity {
 			err := fmt.Errorf("Cannot make such offer: wants to give %d %s, but only have %d", quantity, resource, totalFromOfferedResource)
 			return -1, err
@@ -58,10 +63,14 @@ func (tm *Instance) MakeTradeOffer(
 func (tm *Instance) MakeCounterTradeOffer(
 	playerState *player.Instance,
 	tradeID int,
-	givenResources map[string]int,
-	requestedResources map[string]int,
+	givenResources coreT.Resources,
+	requestedResources coreT.Resources,
 	players []coreT.Player,
 ) (int, error) {
+	if err := validateResources(givenResources, requestedResources); err != nil {
+		return -1, err
+	}
+
 	playerID := playerState.GetID()
 	parentTrade, exists := tm.trades[tradeID]
 	if !exists {
@@ -84,7 +93,7 @@ func (tm *Instance) MakeCounterTradeOffer(
 		return -1, err
 	}
 
-	var offeredResources map[string]int
+	var offeredResources coreT.Resources
 
 	if playerID == parentTrade.Requester {
 		offeredResources = givenResources
@@ -93,15 +102,16 @@ func (tm *Instance) MakeCounterTradeOffer(
 	}
 
 	ownedResources := playerState.GetResources()
-	for resource, quantity := range offeredResources {
-		totalFromOfferedResource := ownedResources[resource]
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := offeredResources.Get(resource)
+		totalFromOfferedResource := ownedResources.Get(resource)
 		if totalFromOfferedResource < quantity {
 			err := fmt.Errorf("Cannot craate counter offer: wants to give %d %s, but only have %d", quantity, resource, totalFromOfferedResource)
 			return -1, err
 		}
 	}
 
-	if equalResourceMaps(givenResources, parentTrade.Offer) && equalResourceMaps(requestedResources, parentTrade.Request) {
+	if givenResources == parentTrade.Offer && requestedResources == parentTrade.Request {
 		return -1, fmt.Errorf("Cannot create counter offer: must be different from the original offer")
 	}
 
@@ -154,8 +164,8 @@ func (tm *Instance) AcceptTradeOffer(playerState *player.Instance, tradeID int)
 	}
 
 	ownedResources := playerState.GetResources()
-	for resource, quantity := range trade.Request {
-		if ownedResources[resource] < quantity {
+	for _, resource := range coreT.ResourcesOrder {
+		if ownedResources.Get(resource) < trade.Request.Get(resource) {
 			err := fmt.Errorf("Cannot accept offer %d: not enough %s", tradeID, resource)
 			return err
 		}
@@ -201,8 +211,9 @@ func (tm *Instance) FinalizeTrade(
 	ownerResources := ownerState.GetResources()
 	var err error
 	// Check if original offerer still has the available resources - could have accepted a different offer in the mean time
-	for resource, quantity := range trade.Offer {
-		totalFromOfferedResource := ownerResources[resource]
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := trade.Offer.Get(resource)
+		totalFromOfferedResource := ownerResources.Get(resource)
 		if totalFromOfferedResource < quantity {
 			err = fmt.Errorf("Offer %d cannot be accepted at the moment: player %s wants to give %d %s, but only has %d", tradeID, trade.Requester, quantity, resource, totalFromOfferedResource)
 			break
@@ -215,8 +226,9 @@ func (tm *Instance) FinalizeTrade(
 
 	accepterResources := accepterState.GetResources()
 	// Check if accepter still has the available resources - could have accepted a different offer in the mean time
-	for resource, quantity := range trade.Request {
-		totalFromRequestedResource := accepterResources[resource]
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := trade.Request.Get(resource)
+		totalFromRequestedResource := accepterResources.Get(resource)
 		if totalFromRequestedResource < quantity {
 			err = fmt.Errorf("Offer %d cannot be accepted at the moment by player %s: they don't have %d %s", tradeID, accepterID, quantity, resource)
 			break
@@ -227,23 +239,15 @@ func (tm *Instance) FinalizeTrade(
 		return err
 	}
 
-	for resource, quantity := range trade.Offer {
-		if quantity > 0 {
-			ownerState.RemoveResource(resource, quantity)
-			tm.bookKeeping.AddTradeResourceGiven(playerID, quantity)
-			accepterState.AddResource(resource, quantity)
-			tm.bookKeeping.AddTradeResourceReceived(accepterID, quantity)
-		}
-	}
+	ownerState.RemoveResources(trade.Offer)
+	accepterState.AddResources(trade.Offer)
+	tm.bookKeeping.AddTradeResourceGiven(playerID, trade.Offer.Total())
+	tm.bookKeeping.AddTradeResourceReceived(accepterID, trade.Offer.Total())
 
-	for resource, quantity := range trade.Request {
-		if quantity > 0 {
-			ownerState.AddResource(resource, quantity)
-			tm.bookKeeping.AddTradeResourceReceived(playerID, quantity)
-			accepterState.RemoveResource(resource, quantity)
-			tm.bookKeeping.AddTradeResourceGiven(accepterID, quantity)
-		}
-	}
+	ownerState.AddResources(trade.Request)
+	accepterState.RemoveResources(trade.Request)
+	tm.bookKeeping.AddTradeResourceReceived(playerID, trade.Request.Total())
+	tm.bookKeeping.AddTradeResourceGiven(accepterID, trade.Request.Total())
 
 	trade.Finalized = true
 	trade.Status = TradeFinalized
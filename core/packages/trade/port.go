@@ -4,15 +4,20 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/player"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
 func (tm *Instance) MakeGeneralPortTrade(
 	playerState *player.Instance,
 	generalPortTradeCost int,
-	givenResources map[string]int,
-	requestedResources map[string]int,
+	givenResources coreT.Resources,
+	requestedResources coreT.Resources,
 ) error {
+	if err := validateResources(givenResources, requestedResources); err != nil {
+		return err
+	}
+
 	ownedPorts := playerState.GetPortTypes()
 	if !utils.SliceContains(ownedPorts, "General") {
 		err := fmt.Errorf("Cannot trade in port General: doesn't own port")
@@ -21,11 +26,12 @@ func (tm *Instance) MakeGeneralPortTrade(
 
 	ownedResources := playerState.GetResources()
 	availableResourcesToRequest := 0
-	for resource, quantity := range givenResources {
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := givenResources.Get(resource)
 		if quantity == 0 {
 			continue
 		}
-		if utils.SliceContains(ownedPorts, resource) {
+		if utils.SliceContains(ownedPorts, string(resource)) {
 			err := fmt.Errorf("Cannot trade %s in General port: owns specific port", resource)
 			return err
 		}
@@ -33,16 +39,16 @@ func (tm *Instance) MakeGeneralPortTrade(
 			err := fmt.Errorf("Cannot trade %d of %s: not a multiple of %d", quantity, resource, generalPortTradeCost)
 			return err
 		}
-		if ownedResources[resource] < quantity {
+		if ownedResources.Get(resource) < quantity {
 			err := fmt.Errorf("Cannot trade %d of %s with port: doesn't have that quantity available", quantity, resource)
 			return err
 		}
 		availableResourcesToRequest += quantity / generalPortTradeCost
 	}
 
-	for resource, quantity := range requestedResources {
-		givenQuantity, ok := givenResources[resource]
-		if ok && givenQuantity > 0 && quantity > 0 {
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := requestedResources.Get(resource)
+		if givenResources.Get(resource) > 0 && quantity > 0 {
 			err := fmt.Errorf("Cannot complete port trade: giving and requesting %s", resource)
 			return err
 		}
@@ -53,29 +59,33 @@ func (tm *Instance) MakeGeneralPortTrade(
 		return err
 	}
 
-	for resource, quantity := range givenResources {
-		playerState.RemoveResource(resource, quantity)
-	}
-	for resource, quantity := range requestedResources {
-		playerState.AddResource(resource, quantity)
+	if !tm.bank.Has(requestedResources) {
+		err := fmt.Errorf("Cannot complete port trade: bank doesn't have the requested resources")
+		return err
 	}
+	tm.exchangeWithBank(playerState, givenResources, requestedResources)
 	return nil
 }
 
 func (tm *Instance) MakeResourcePortTrade(
 	playerState *player.Instance,
 	resourcePortTradeCost int,
-	givenResources map[string]int,
-	requestedResources map[string]int,
+	givenResources coreT.Resources,
+	requestedResources coreT.Resources,
 ) error {
+	if err := validateResources(givenResources, requestedResources); err != nil {
+		return err
+	}
+
 	ownedPorts := playerState.GetPortTypes()
 	ownedResources := playerState.GetResources()
 	availableResourcesToRequest := 0
-	for resource, quantity := range givenResources {
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := givenResources.Get(resource)
 		if quantity == 0 {
 			continue
 		}
-		if !utils.SliceContains(ownedPorts, resource) {
+		if !utils.SliceContains(ownedPorts, string(resource)) {
 			err := fmt.Errorf("Cannot trade in port %s: doesn't own port", resource)
 			return err
 		}
@@ -83,16 +93,16 @@ func (tm *Instance) MakeResourcePortTrade(
 			err := fmt.Errorf("Cannot trade %d of %s: not a multiple of %d", quantity, resource, resourcePortTradeCost)
 			return err
 		}
-		if ownedResources[resource] < quantity {
+		if ownedResources.Get(resource) < quantity {
 			err := fmt.Errorf("Cannot trade %d of %s with port: doesn't have that quantity available", quantity, resource)
 			return err
 		}
 		availableResourcesToRequest += quantity / resourcePortTradeCost
 	}
 
-	for resource, quantity := range requestedResources {
-		givenQuantity, ok := givenResources[resource]
-		if ok && givenQuantity > 0 && quantity > 0 {
+	for _, resource := range coreT.ResourcesOrder {
+		quantity := requestedResources.Get(resource)
+		if givenResources.Get(resource) > 0 && quantity > 0 {
 			err := fmt.Errorf("Cannot complete port trade: giving and requesting %s", resource)
 			return err
 		}
@@ -103,11 +113,10 @@ func (tm *Instance) MakeResourcePortTrade(
 		return err
 	}
 
-	for resource, quantity := range givenResources {
-		playerState.RemoveResource(resource, quantity)
-	}
-	for resource, quantity := range requestedResources {
-		playerState.AddResource(resource, quantity)
+	if !tm.bank.Has(requestedResources) {
+		err := fmt.Errorf("Cannot complete port trade: bank doesn't have the requested resources")
+		return err
 	}
+	tm.exchangeWithBank(playerState, givenResources, requestedResources)
 	return nil
 }
@@ -0,0 +1,65 @@
+package trade
+
+import (
+	"github.com/victoroliveirab/settlers/core/packages/bank"
+	"github.com/victoroliveirab/settlers/core/packages/book-keeping"
+)
+
+type Snapshot struct {
+	Trades           []Trade       `json:"trades"`
+	ParentToChildMap map[int][]int `json:"parentToChildMap"`
+	NextTradeID      int           `json:"nextTradeID"`
+}
+
+func (tm *Instance) Snapshot() Snapshot {
+	trades := tm.Trades()
+	for i := range trades {
+		trades[i] = copyTrade(&trades[i])
+	}
+	parentToChildMap := make(map[int][]int)
+	for parentID, children := range tm.parentToChildMap {
+		parentToChildMap[parentID] = append([]int{}, children...)
+	}
+	return Snapshot{
+		Trades:           trades,
+		ParentToChildMap: parentToChildMap,
+		NextTradeID:      tm.nextTradeID,
+	}
+}
+
+func FromSnapshot(bookKeepingHandler *bookkeeping.Instance, bankHandler *bank.Instance, snapshot Snapshot) *Instance {
+	tm := New(bookKeepingHandler, bankHandler)
+	for i := range snapshot.Trades {
+		trade := copyTrade(&snapshot.Trades[i])
+		tm.trades[trade.ID] = &trade
+	}
+	for parentID, children := range snapshot.ParentToChildMap {
+		tm.parentToChildMap[parentID] = append([]int{}, children...)
+	}
+	tm.nextTradeID = snapshot.NextTradeID
+	return tm
+}
+
+func copyTrade(trade *Trade) Trade {
+	copied := *trade
+	copied.Responses = make(map[string]*TradePlayerEntry, len(trade.Responses))
+	for playerID, response := range trade.Responses {
+		entry := *response
+		copied.Responses[playerID] = &entry
+	}
+	return copied
+}
+
+// Clone copies the trades over to a game with its own book keeping and bank
+func (tm *Instance) Clone(bookKeepingHandler *bookkeeping.Instance, bankHandler *bank.Instance) *Instance {
+	clone := New(bookKeepingHandler, bankHandler)
+	for tradeID, trade := range tm.trades {
+		copied := copyTrade(trade)
+		clone.trades[tradeID] = &copied
+	}
+	for parentID, children := range tm.parentToChildMap {
+		clone.parentToChildMap[parentID] = append([]int{}, children...)
+	}
+	clone.nextTradeID = tm.nextTradeID
+	return clone
+}
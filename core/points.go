@@ -27,23 +27,33 @@ func (state *GameState) recountLongestRoad() bool {
 
 	// NOTE: this has the potential to reset longest road if a settlement blocks a previous largest
 	// road of 5. I don't know what should be done rulewise, so I chose to revoke the title
+	previousPlayerID := state.longestRoad.PlayerID
 	if longestRoad.Length < state.longestRoadMinimum {
-		changed := state.longestRoad.PlayerID != ""
 		state.longestRoad = LongestRoad{
 			PlayerID: "",
 			Length:   0,
 		}
-		return changed
+		if previousPlayerID == "" {
+			return false
+		}
+		state.emit(LongestRoadChanged{PreviousPlayerID: previousPlayerID})
+		return true
 	}
 
-	if longestRoad.PlayerID != state.longestRoad.PlayerID {
+	if longestRoad.PlayerID != previousPlayerID {
 		state.longestRoad.PlayerID = longestRoad.PlayerID
+		state.emit(LongestRoadChanged{
+			PreviousPlayerID: previousPlayerID,
+			PlayerID:         longestRoad.PlayerID,
+			Length:           longestRoad.Length,
+		})
 		return true
 	}
 	return false
 }
 
 func (state *GameState) recountKnights() bool {
+	previousPlayerID := state.mostKnights.PlayerID
 	changed := false
 	for _, player := range state.players {
 		playerState := state.playersStates[player.ID]
@@ -54,6 +64,13 @@ func (state *GameState) recountKnights() bool {
 			changed = true
 		}
 	}
+	if state.mostKnights.PlayerID != previousPlayerID {
+		state.emit(LargestArmyChanged{
+			PreviousPlayerID: previousPlayerID,
+			PlayerID:         state.mostKnights.PlayerID,
+			Quantity:         state.mostKnights.Quantity,
+		})
+	}
 	return changed
 }
 
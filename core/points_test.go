@@ -220,13 +220,13 @@ func TestPointsMultipleConfigurations(t *testing.T) {
 func TestPointsIncreaseOnSettlementBuild(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -286,13 +286,13 @@ func TestPointsIncreaseOnSettlementBuild(t *testing.T) {
 func TestPointsIncreaseOnCityBuild(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -352,13 +352,13 @@ func TestPointsIncreaseOnCityBuild(t *testing.T) {
 func TestPointsIncreaseOnLongestRoadAchieved(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -418,13 +418,13 @@ func TestPointsIncreaseOnLongestRoadAchieved(t *testing.T) {
 func TestPointsIncreaseOnLongestRoadStolen(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -503,13 +503,13 @@ func TestPointsIncreaseOnLongestRoadStolen(t *testing.T) {
 func TestPointsDecreaseOnLongestRoadRevoked(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -576,13 +576,13 @@ func TestPointsDecreaseOnLongestRoadRevoked(t *testing.T) {
 func TestPointsIncreaseOnFirstMostKnights(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -653,13 +653,13 @@ func TestPointsIncreaseOnStolenMostKnights(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
 		MockWithCurrentRoundPlayer("2"),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"2": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -739,13 +739,13 @@ func TestPointsIncreaseOnStolenMostKnights(t *testing.T) {
 func TestGameOverOnTargetPointAchievedByBuildingSettlement(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -811,13 +811,13 @@ func TestGameOverOnTargetPointAchievedByBuildingSettlement(t *testing.T) {
 func TestGameOverOnTargetPointAchievedByBuildingCity(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -879,13 +879,13 @@ func TestGameOverOnTargetPointAchievedByBuildingCity(t *testing.T) {
 func TestGameOverOnTargetPointAchievedByBuildingLongestRoad(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -932,13 +932,13 @@ func TestGameOverOnTargetPointAchievedByBuildingLongestRoad(t *testing.T) {
 func TestGameOverOnTargetPointAchievedByAcquiringMostKnightsUse(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -1001,13 +1001,13 @@ func TestGameOverOnTargetPointAchievedByAcquiringMostKnightsUse(t *testing.T) {
 func TestGameOverOnTargetPointAchievedByBuyingVictoryPoint(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 10,
-				"Brick":  10,
-				"Sheep":  10,
-				"Grain":  10,
-				"Ore":    10,
+				Lumber: 10,
+				Brick:  10,
+				Sheep:  10,
+				Grain:  10,
+				Ore:    10,
 			},
 		}),
 		MockWithSettlementsByPlayer(map[string][]int{
@@ -2,12 +2,16 @@ package core
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
-func (state *GameState) BuildRoad(playerID string, edgeID int) error {
+func (state *GameState) BuildRoad(playerID string, edgeID int) (err error) {
+	defer state.record(Command{Type: CommandBuildRoad, PlayerID: playerID, EdgeID: edgeID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot build road during other player's turn")
 		return err
@@ -19,15 +23,18 @@ func (state *GameState) BuildRoad(playerID string, edgeID int) error {
 		return state.PickRoadBuildingSpot(playerID, edgeID)
 	}
 
-	if roundType != round.SetupRoad1 && roundType != round.SetupRoad2 && roundType != round.Regular {
+	if roundType != round.SetupRoad1 && roundType != round.SetupRoad2 && !state.isBuildingRound() {
 		err := fmt.Errorf("Cannot build road during %s", state.round.GetCurrentRoundTypeDescription())
 		return err
 	}
 
 	edge, exists := state.board.GetRoads()[edgeID]
 	if exists {
-		owner := state.findPlayer(edge.Owner)
-		err := fmt.Errorf("Player %s already has road at edge #%d", owner, edgeID)
+		err := fmt.Errorf("Player %s already has road at edge #%d", edge.Owner, edgeID)
+		return err
+	}
+
+	if err := state.checkRoadOffShore(edgeID); err != nil {
 		return err
 	}
 
@@ -43,8 +50,7 @@ func (state *GameState) BuildRoad(playerID string, edgeID int) error {
 
 	playerState := state.playersStates[playerID]
 
-	resources := playerState.GetResources()
-	if resources["Lumber"] < 1 || resources["Brick"] < 1 {
+	if !playerState.GetResources().Covers(coreT.RoadCost) {
 		err := fmt.Errorf("Insufficient resources to build a road")
 		return err
 	}
@@ -60,13 +66,28 @@ func (state *GameState) BuildRoad(playerID string, edgeID int) error {
 		return err
 	}
 
-	playerState.RemoveResource("Lumber", 1)
-	playerState.RemoveResource("Brick", 1)
+	state.payToBank(playerID, coreT.RoadCost)
 	state.handleNewRoad(playerID, edgeID)
+	if state.twoPlayer {
+		state.growNeutralRoad()
+	}
 
 	return nil
 }
 
+// checkRoadOffShore checks edgeID has no ship and isn't out at sea
+func (state *GameState) checkRoadOffShore(edgeID int) error {
+	if ship, exists := state.board.GetShips()[edgeID]; exists {
+		err := fmt.Errorf("Player %s already has ship at edge #%d", ship.Owner, edgeID)
+		return err
+	}
+	if state.isSeaEdge(edgeID) {
+		err := fmt.Errorf("Cannot build road at sea (edge#%d)", edgeID)
+		return err
+	}
+	return nil
+}
+
 func (state *GameState) isEdgeAllowedSetupPhase(playerID string, edgeID int) bool {
 	playerState := state.playersStates[playerID]
 	vertexID := utils.SliceLast(playerState.GetSettlements())
@@ -78,6 +99,8 @@ func (state *GameState) handleNewRoad(playerID string, edgeID int) {
 	state.board.AddRoad(playerID, edgeID)
 	playerState := state.playersStates[playerID]
 	playerState.AddRoad(edgeID)
+	state.emit(RoadBuilt{PlayerID: playerID, EdgeID: edgeID})
+	state.exploreAroundEdge(playerID, edgeID)
 
 	state.computeLongestRoad(playerID)
 	changed := state.recountLongestRoad()
@@ -86,10 +109,16 @@ func (state *GameState) handleNewRoad(playerID string, edgeID int) {
 	}
 }
 
+// computeLongestRoad finds the longest trade route of the player, made of roads and ships.
+// A route may only switch between roads and ships at a settlement or city of the player
 func (state *GameState) computeLongestRoad(playerID string) {
 	graph := make(map[int][]int)
 	playerState := state.playersStates[playerID]
-	for _, edgeID := range playerState.GetRoads() {
+	isShip := make(map[int]bool)
+	for _, edgeID := range playerState.GetShips() {
+		isShip[edgeID] = true
+	}
+	for _, edgeID := range append(slices.Clone(playerState.GetRoads()), playerState.GetShips()...) {
 		edge := state.board.Definition.VerticesByEdge[edgeID]
 		vertex1 := edge[0]
 		vertex2 := edge[1]
@@ -117,8 +146,15 @@ func (state *GameState) computeLongestRoad(playerID string) {
 			maxPath = append([]int{}, path...)
 		}
 
+		settlement, settlementExists := settlements[node]
+		city, cityExists := cities[node]
+		ownsNode := (settlementExists && settlement.Owner == playerID) || (cityExists && city.Owner == playerID)
+
 		for _, edgeID := range graph[node] {
 			if !visited[edgeID] {
+				if len(path) > 0 && isShip[path[len(path)-1]] != isShip[edgeID] && !ownsNode {
+					continue
+				}
 				var vertex int
 				if state.board.Definition.VerticesByEdge[edgeID][0] == node {
 					vertex = state.board.Definition.VerticesByEdge[edgeID][1]
@@ -154,7 +190,7 @@ func (state *GameState) AvailableEdges(playerID string) ([]int, error) {
 	}
 
 	roundType := state.round.GetRoundType()
-	if roundType != round.SetupRoad1 && roundType != round.SetupRoad2 && roundType != round.Regular && roundType != round.BuildRoad1Development && roundType != round.BuildRoad2Development {
+	if roundType != round.SetupRoad1 && roundType != round.SetupRoad2 && !state.isBuildingRound() && roundType != round.BuildRoad1Development && roundType != round.BuildRoad2Development {
 		err := fmt.Errorf("Cannot check available edges during %s", state.round.GetCurrentRoundTypeDescription())
 		return []int{}, err
 	}
@@ -163,7 +199,12 @@ func (state *GameState) AvailableEdges(playerID string) ([]int, error) {
 
 	if roundType == round.SetupRoad1 || roundType == round.SetupRoad2 {
 		vertexID := utils.SliceLast(playerState.GetSettlements())
-		allowedEdgesIDs := state.board.Definition.EdgesByVertex[vertexID]
+		allowedEdgesIDs := make([]int, 0)
+		for _, edgeID := range state.board.Definition.EdgesByVertex[vertexID] {
+			if !state.isSeaEdge(edgeID) {
+				allowedEdgesIDs = append(allowedEdgesIDs, edgeID)
+			}
+		}
 		return allowedEdgesIDs, nil
 	}
 
@@ -215,5 +256,12 @@ func (state *GameState) AvailableEdges(playerID string) ([]int, error) {
 		}
 	}
 
+	for edgeID := range state.board.GetShips() {
+		edges.Remove(edgeID)
+	}
+	for edgeID := range state.board.Definition.SeaEdges {
+		edges.Remove(edgeID)
+	}
+
 	return edges.Values(), nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/victoroliveirab/settlers/core/packages/board"
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
@@ -47,13 +48,13 @@ func TestBuildRoadRegularPhaseTouchingSettlementSuccess(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -80,20 +81,20 @@ func TestBuildRoadRegularPhaseTouchingSettlementSuccess(t *testing.T) {
 
 		player1ResourcesAfterBuild := game.ResourceHandByPlayer("1")
 
-		if player1ResourcesAfterBuild["Lumber"] != 3 {
-			t.Errorf("expected to have 3 Lumber after build road, but found %d", player1ResourcesAfterBuild["Lumber"])
+		if player1ResourcesAfterBuild.Lumber != 3 {
+			t.Errorf("expected to have 3 Lumber after build road, but found %d", player1ResourcesAfterBuild.Lumber)
 		}
 
-		if player1ResourcesAfterBuild["Brick"] != 2 {
-			t.Errorf("expected to have 2 Brick after build road, but found %d", player1ResourcesAfterBuild["Brick"])
+		if player1ResourcesAfterBuild.Brick != 2 {
+			t.Errorf("expected to have 2 Brick after build road, but found %d", player1ResourcesAfterBuild.Brick)
 		}
 
-		if player1ResourcesAfterBuild["Sheep"] != 2 {
-			t.Errorf("expected to have 2 Sheep after build road, but found %d", player1ResourcesAfterBuild["Sheep"])
+		if player1ResourcesAfterBuild.Sheep != 2 {
+			t.Errorf("expected to have 2 Sheep after build road, but found %d", player1ResourcesAfterBuild.Sheep)
 		}
 
-		if player1ResourcesAfterBuild["Grain"] != 1 {
-			t.Errorf("expected to have 1 Grain after build road, but found %d", player1ResourcesAfterBuild["Grain"])
+		if player1ResourcesAfterBuild.Grain != 1 {
+			t.Errorf("expected to have 1 Grain after build road, but found %d", player1ResourcesAfterBuild.Grain)
 		}
 	})
 }
@@ -104,13 +105,13 @@ func TestBuildRoadRegularPhaseTouchingRoadSuccess(t *testing.T) {
 		MockWithRoadsByPlayer(map[string][]int{
 			"1": {54},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -137,20 +138,20 @@ func TestBuildRoadRegularPhaseTouchingRoadSuccess(t *testing.T) {
 
 		player1ResourcesAfterBuild := game.ResourceHandByPlayer("1")
 
-		if player1ResourcesAfterBuild["Lumber"] != 3 {
-			t.Errorf("expected to have 3 Lumber after build road, but found %d", player1ResourcesAfterBuild["Lumber"])
+		if player1ResourcesAfterBuild.Lumber != 3 {
+			t.Errorf("expected to have 3 Lumber after build road, but found %d", player1ResourcesAfterBuild.Lumber)
 		}
 
-		if player1ResourcesAfterBuild["Brick"] != 2 {
-			t.Errorf("expected to have 2 Brick after build road, but found %d", player1ResourcesAfterBuild["Brick"])
+		if player1ResourcesAfterBuild.Brick != 2 {
+			t.Errorf("expected to have 2 Brick after build road, but found %d", player1ResourcesAfterBuild.Brick)
 		}
 
-		if player1ResourcesAfterBuild["Sheep"] != 2 {
-			t.Errorf("expected to have 2 Sheep after build road, but found %d", player1ResourcesAfterBuild["Sheep"])
+		if player1ResourcesAfterBuild.Sheep != 2 {
+			t.Errorf("expected to have 2 Sheep after build road, but found %d", player1ResourcesAfterBuild.Sheep)
 		}
 
-		if player1ResourcesAfterBuild["Grain"] != 1 {
-			t.Errorf("expected to have 1 Grain after build road, but found %d", player1ResourcesAfterBuild["Grain"])
+		if player1ResourcesAfterBuild.Grain != 1 {
+			t.Errorf("expected to have 1 Grain after build road, but found %d", player1ResourcesAfterBuild.Grain)
 		}
 	})
 }
@@ -180,13 +181,13 @@ func TestBuildRoadErrorAlreadyExists(t *testing.T) {
 		MockWithRoadsByPlayer(map[string][]int{
 			"1": {65},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -205,13 +206,13 @@ func TestBuildRoadErrorNotPlayerRound(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -229,13 +230,13 @@ func TestBuildRoadErrorNotEnoughResources(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  0,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  0,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -253,13 +254,13 @@ func TestBuildRoadErrorNotAppropriateRound(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -277,13 +278,13 @@ func TestBuildRoadErrorNotRoadOrSettlementAttached(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"1": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
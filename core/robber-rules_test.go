@@ -0,0 +1,110 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// Tile#17 touches player 2's settlement at vertex#42
+func TestFriendlyRobber(t *testing.T) {
+	setup := func(opts ...GameStateOption) *GameState {
+		opts = append([]GameStateOption{
+			MockWithRoundType(round.MoveRobberDue7),
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {1},
+				"2": {42},
+			}),
+			MockWithPoints(),
+		}, opts...)
+		return CreateTestGame(opts...)
+	}
+
+	t.Run("robber keeps off tiles of players with few points", func(t *testing.T) {
+		game := setup(MockWithFriendlyRobber(2))
+		if utils.SliceContains(game.RobberDestinations(), 17) {
+			t.Errorf("expected tile#17 to not be a robber destination, actually got %v", game.RobberDestinations())
+		}
+		for _, action := range game.LegalActions("1") {
+			if action.Type == CommandMoveRobber && action.TileID == 17 {
+				t.Errorf("expected to not be offered to move robber to tile#17")
+			}
+		}
+		err := game.MoveRobber("1", 17)
+		if err == nil {
+			t.Errorf("expected to not be able to move robber to tile#17 since player#2 has 1 point")
+		}
+	})
+
+	t.Run("players past the limit aren't protected", func(t *testing.T) {
+		game := setup(MockWithFriendlyRobber(1), MockWithCitiesByPlayer(map[string][]int{"2": {42}}), MockWithPoints())
+		err := game.MoveRobber("1", 17)
+		if err != nil {
+			t.Errorf("expected to move robber to tile#17 just fine since player#2 has 2 points, but actually got error %s", err.Error())
+		}
+	})
+
+	t.Run("friendly robber is off by default", func(t *testing.T) {
+		game := setup()
+		if !utils.SliceContains(game.RobberDestinations(), 17) {
+			t.Errorf("expected tile#17 to be a robber destination, actually got %v", game.RobberDestinations())
+		}
+	})
+}
+
+func TestRobberProtectionRounds(t *testing.T) {
+	setup := func(roundNumber int) *GameState {
+		return CreateTestGame(
+			MockWithRoundType(round.BetweenTurns),
+			MockWithRoundNumber(roundNumber),
+			MockWithRobberProtectionRounds(4),
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {1},
+				"2": {42},
+			}),
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
+				"2": {Lumber: 2, Brick: 2, Sheep: 2, Grain: 2},
+			}),
+			MockWithRand(StubRand(7)),
+		)
+	}
+
+	t.Run("7s make nobody discard and the robber robs nobody", func(t *testing.T) {
+		game := setup(3)
+		game.RollDice("1")
+		if game.round.GetRoundType() != round.MoveRobberDue7 {
+			t.Fatalf("expected round type to be %s, but it's actually %s", game.round.GetRoundTypeDescription(round.MoveRobberDue7), game.round.GetCurrentRoundTypeDescription())
+		}
+
+		err := game.MoveRobber("1", 17)
+		if err != nil {
+			t.Fatalf("expected to move robber to tile#17 just fine, but actually got error %s", err.Error())
+		}
+		if game.round.GetRoundType() != round.Regular {
+			t.Errorf("expected round type to be %s, but it's actually %s", game.round.GetRoundTypeDescription(round.Regular), game.round.GetCurrentRoundTypeDescription())
+		}
+		if game.NumberOfCardsInHandByPlayer("2") != 8 {
+			t.Errorf("expected player#2 to keep 8 cards, but actually has %d", game.NumberOfCardsInHandByPlayer("2"))
+		}
+	})
+
+	t.Run("robbing resumes after the protection rounds", func(t *testing.T) {
+		game := setup(4)
+		game.RollDice("1")
+		if game.round.GetRoundType() != round.DiscardPhase {
+			t.Errorf("expected round type to be %s, but it's actually %s", game.round.GetRoundTypeDescription(round.DiscardPhase), game.round.GetCurrentRoundTypeDescription())
+		}
+	})
+
+	t.Run("can't rob during the protection rounds", func(t *testing.T) {
+		game := setup(0)
+		MockWithRoundType(round.PickRobbed)(game)
+		MockWithBlockedTile(17)(game)
+		err := game.RobPlayer("1", "2")
+		if err == nil {
+			t.Errorf("expected to not be able to rob player#2 before round 5")
+		}
+	})
+}
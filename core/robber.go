@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
 // TODO: add prevTileID here
-func (state *GameState) MoveRobber(playerID string, tileID int) error {
+func (state *GameState) MoveRobber(playerID string, tileID int) (err error) {
+	defer state.record(Command{Type: CommandMoveRobber, PlayerID: playerID, TileID: tileID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot move robber during other player's turn")
 		return err
@@ -20,6 +23,16 @@ func (state *GameState) MoveRobber(playerID string, tileID int) error {
 		return err
 	}
 
+	if state.isTileHidden(tileID) {
+		err := fmt.Errorf("Cannot move robber to unexplored tile - %d", tileID)
+		return err
+	}
+
+	if state.isTileProtected(tileID) && !utils.SliceContains(state.RobberDestinations(), tileID) {
+		err := fmt.Errorf("Cannot move robber to tile - %d: it touches a player with %d points or fewer", tileID, state.friendlyRobber)
+		return err
+	}
+
 	for i, tile := range state.board.GetTiles() {
 		if tile.Blocked {
 			if tile.ID == tileID {
@@ -48,12 +61,16 @@ func (state *GameState) MoveRobber(playerID string, tileID int) error {
 		}
 	}
 	// Should never be reached
-	err := fmt.Errorf("Cannot move robber to non-existent tile - %d", tileID)
+	err = fmt.Errorf("Cannot move robber to non-existent tile - %d", tileID)
 	return err
 }
 
+// RobPlayer takes a random card from robbedID and ends PickRobbed. Any robbable player can be picked, even one with
+// no cards: nothing is taken then, and PlayerRobbed is emitted with an empty Resource.
 // FIXME: this function is insecure since there's no guarantee that it is moving to the tile it just moved the robber
-func (state *GameState) RobPlayer(robberID string, robbedID string) error {
+func (state *GameState) RobPlayer(robberID string, robbedID string) (err error) {
+	defer state.record(Command{Type: CommandRobPlayer, PlayerID: robberID, TargetPlayerID: robbedID})(&err)
+
 	if robberID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot rob during other player's turn")
 		return err
@@ -64,6 +81,11 @@ func (state *GameState) RobPlayer(robberID string, robbedID string) error {
 		return err
 	}
 
+	if state.IsRobbingSuspended() {
+		err := fmt.Errorf("Cannot rob until round %d", state.robberProtectionRounds+1)
+		return err
+	}
+
 	robbablePlayers, _ := state.RobbablePlayers(robberID)
 	if !utils.SliceContains(robbablePlayers, robbedID) {
 		err := fmt.Errorf("Cannot rob %s: not in the blocked tile", robbedID)
@@ -75,33 +97,35 @@ func (state *GameState) RobPlayer(robberID string, robbedID string) error {
 		return err
 	}
 
-	dice := state.round.GetDice()
-	if dice[0] == 0 && dice[1] == 0 {
-		state.round.SetRoundType(round.BetweenTurns)
-	} else {
-		state.round.SetRoundType(round.Regular)
-	}
-
 	robbedState := state.playersStates[robbedID]
 	robbedPlayerResources := robbedState.GetResources()
 
-	resources := make([]string, 0)
-	for _, resourceName := range ResourcesOrder {
-		quantity := robbedPlayerResources[resourceName]
+	resources := make([]coreT.Resource, 0)
+	for _, resource := range ResourcesOrder {
+		quantity := robbedPlayerResources.Get(resource)
 		for i := 0; i < quantity; i++ {
-			resources = append(resources, resourceName)
+			resources = append(resources, resource)
 		}
 	}
 
+	dice := state.round.GetDice()
+	if dice[0] == 0 && dice[1] == 0 {
+		state.round.SetRoundType(round.BetweenTurns)
+	} else {
+		state.round.SetRoundType(round.Regular)
+	}
+
+	// Robbing a player with no cards takes nothing, but still ends PickRobbed
 	if len(resources) == 0 {
-		err := fmt.Errorf("Cannot rob a player that has no cards")
-		return err
+		state.emit(PlayerRobbed{PlayerID: robberID, TargetPlayerID: robbedID})
+		return nil
 	}
 
 	robbedResource := resources[state.rand.Intn(len(resources))]
 
 	robbedState.RemoveResource(robbedResource, 1)
 	state.playersStates[robberID].AddResource(robbedResource, 1)
+	state.emit(PlayerRobbed{PlayerID: robberID, TargetPlayerID: robbedID, Resource: robbedResource})
 	return nil
 }
 
@@ -112,3 +136,58 @@ func (state *GameState) BlockedTiles() []int {
 func (state *GameState) UnblockedTiles() []int {
 	return state.board.GetUnblockedTilesIDs()
 }
+
+// RobberDestinations lists the tiles the robber may move to: explored tiles it isn't on. With the friendly
+// robber, tiles touching a protected player are left out, unless every tile does
+func (state *GameState) RobberDestinations() []int {
+	tileIDs := make([]int, 0)
+	for _, tileID := range state.board.GetUnblockedTilesIDs() {
+		if !state.isTileHidden(tileID) {
+			tileIDs = append(tileIDs, tileID)
+		}
+	}
+
+	friendlyTileIDs := make([]int, 0)
+	for _, tileID := range tileIDs {
+		if !state.isTileProtected(tileID) {
+			friendlyTileIDs = append(friendlyTileIDs, tileID)
+		}
+	}
+	if len(friendlyTileIDs) == 0 {
+		return tileIDs
+	}
+	return friendlyTileIDs
+}
+
+// isTileProtected tells whether the friendly robber keeps off tileID, for it touches a player with few points
+func (state *GameState) isTileProtected(tileID int) bool {
+	if state.friendlyRobber == 0 {
+		return false
+	}
+	points := state.PublicPoints()
+	settlements := state.board.GetSettlements()
+	cities := state.board.GetCities()
+	for _, vertexID := range state.board.Definition.VerticesByTile[tileID] {
+		ownerID := ""
+		if settlement, hasSettlement := settlements[vertexID]; hasSettlement {
+			ownerID = settlement.Owner
+		} else if city, hasCity := cities[vertexID]; hasCity {
+			ownerID = city.Owner
+		}
+		// Neutral players are never protected
+		if _, isPlayer := state.playersStates[ownerID]; isPlayer && points[ownerID] <= state.friendlyRobber {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRobbingSuspended tells whether the game is still in its first rounds, in which the robber robs nobody
+// and 7s make nobody discard
+func (state *GameState) IsRobbingSuspended() bool {
+	return state.round.GetRoundNumber() < state.robberProtectionRounds
+}
+
+func (state *GameState) RobberProtectionRounds() int {
+	return state.robberProtectionRounds
+}
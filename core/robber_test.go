@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestHandleDice7TilePlayerHasCards(t *testing.T) {
@@ -14,20 +15,20 @@ func TestHandleDice7TilePlayerHasCards(t *testing.T) {
 			"1": {1},
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithRand(rand),
@@ -73,20 +74,20 @@ func TestHandleDice7TilePlayerHasNoCards(t *testing.T) {
 			"1": {1},
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 0,
-				"Brick":  0,
-				"Sheep":  0,
-				"Grain":  0,
-				"Ore":    0,
+				Lumber: 0,
+				Brick:  0,
+				Sheep:  0,
+				Grain:  0,
+				Ore:    0,
 			},
 		}),
 		MockWithRand(rand),
@@ -103,9 +104,22 @@ func TestHandleDice7TilePlayerHasNoCards(t *testing.T) {
 			t.Errorf("expected to move robber to tile#17 just fine, but actually got error %s", err.Error())
 		}
 
+		var robbed *PlayerRobbed
+		game.Subscribe(func(event Event) {
+			if event, ok := event.(PlayerRobbed); ok {
+				robbed = &event
+			}
+		})
 		err = game.RobPlayer("1", "2")
-		if err == nil {
-			t.Errorf("expected to have error since player#2 has no cards, but actually no error was found")
+		if err != nil {
+			t.Errorf("expected to rob nothing from player#2 just fine, but actually got error %s", err.Error())
+		}
+		if robbed == nil || robbed.TargetPlayerID != "2" || robbed.Resource != "" {
+			t.Errorf("expected PlayerRobbed of player#2 with no resource, but actually got %+v", robbed)
+		}
+		commands := game.ActionLog().Commands
+		if lastCommand := commands[len(commands)-1]; lastCommand.Type != CommandRobPlayer || lastCommand.TargetPlayerID != "2" {
+			t.Errorf("expected robbing player#2 to be recorded, but last recorded command is %+v", lastCommand)
 		}
 
 		roundType := game.round.GetRoundType()
@@ -132,13 +146,13 @@ func TestHandleDice7TilePlayerRobsItself(t *testing.T) {
 			"1": {1},
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithRand(rand),
@@ -180,20 +194,20 @@ func TestHandleDice7MoveRobberToNotOwnedTile(t *testing.T) {
 			"1": {1},
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithRand(rand),
@@ -234,20 +248,20 @@ func TestHandleDice7MoveRobberToTileOnlyOwnedByPlayer(t *testing.T) {
 			"1": {1},
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithRand(rand),
@@ -280,27 +294,27 @@ func TestHandleDice7MoveRobberToOwnedTileButTriesToRobUnaffectedPlayer(t *testin
 			"2": {42},
 			"3": {3},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"3": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithRand(rand),
@@ -3,7 +3,9 @@ package core
 import (
 	"fmt"
 
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
@@ -43,6 +45,7 @@ func (state *GameState) handleChangeSetupRoundType() {
 
 func (state *GameState) handOffInitialResources() {
 	tiles := state.board.GetTiles()
+	owed := make(map[coreT.Resource]map[string]int)
 	for _, player := range state.players {
 		playerState := state.playersStates[player.ID]
 		settlementsIDs := playerState.GetSettlements()
@@ -50,15 +53,25 @@ func (state *GameState) handOffInitialResources() {
 		tilesIndexes := state.board.Definition.TilesByVertex[vertexID]
 		for _, index := range tilesIndexes {
 			tile := tiles[index]
-			if tile.Resource == "Desert" {
+			if tile.Resource == "Desert" || tile.Resource == coreMaps.GoldResource {
 				continue
 			}
-			playerState.AddResource(tile.Resource, 1)
+			addOwed(owed, coreT.Resource(tile.Resource), player.ID, 1)
 		}
 	}
+	state.payFromBank(owed)
 }
 
-func (state *GameState) RollDice(playerID string) error {
+func addOwed(owed map[coreT.Resource]map[string]int, resource coreT.Resource, playerID string, quantity int) {
+	if _, exists := owed[resource]; !exists {
+		owed[resource] = make(map[string]int)
+	}
+	owed[resource][playerID] += quantity
+}
+
+func (state *GameState) RollDice(playerID string) (err error) {
+	defer state.record(Command{Type: CommandRollDice, PlayerID: playerID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot roll dice during other player's turn")
 		return err
@@ -76,50 +89,89 @@ func (state *GameState) RollDice(playerID string) error {
 		return err
 	}
 
-	dice1 := state.rand.Intn(6) + 1
-	dice2 := state.rand.Intn(6) + 1
+	dice1, dice2 := state.dice.Roll(state.rand, playerID)
 	state.round.SetDice(dice1, dice2)
 	sum := dice1 + dice2
 	state.bookKeeping.AddDiceEntry(playerID, sum)
+	sums := []int{sum}
+	if state.twoPlayer {
+		sums = append(sums, state.rollSecondDice(playerID, sum))
+	}
 
-	if sum == 7 {
-		state.handle7()
+	for _, sum := range sums {
+		if sum != 7 {
+			state.produceResources(sum)
+		}
+	}
+	if state.hasGoldToPick() {
+		state.round.SetRoundType(round.GoldPick)
+		state.endGoldPickIfDone()
 		return nil
 	}
+	state.resumeAfterProduction()
+	return nil
+}
+
+// resumeAfterProduction moves on once the rolled resources are handed out
+func (state *GameState) resumeAfterProduction() {
+	dice := state.round.GetDice()
+	secondDice := state.round.GetSecondDice()
+	if dice[0]+dice[1] == 7 || secondDice[0]+secondDice[1] == 7 {
+		state.handle7()
+		return
+	}
+	state.round.SetRoundType(round.Regular)
+}
 
+func (state *GameState) produceResources(sum int) {
+	owed := make(map[coreT.Resource]map[string]int)
+	blocked := make(map[string]coreT.Resources)
 	for _, tile := range state.board.GetTiles() {
 		if tile.Token != sum || tile.Resource == "Desert" {
 			continue
 		}
+		if tile.Resource == coreMaps.GoldResource {
+			state.addGoldToPick(tile)
+			continue
+		}
+		resource := coreT.Resource(tile.Resource)
 		for _, vertice := range tile.Vertices {
 			for _, player := range state.players {
 				playerState := state.playersStates[player.ID]
 				settlements := playerState.GetSettlements()
 				if utils.SliceContains(settlements, vertice) {
 					if tile.Blocked {
-						state.bookKeeping.AddResourcesBlocked(player.ID, tile.Resource, 1)
+						blocked[player.ID] = blocked[player.ID].Add(coreT.ResourcesOf(resource, 1))
 					} else {
-						playerState.AddResource(tile.Resource, 1)
-						state.bookKeeping.AddResourceDrawn(player.ID, tile.Resource, 1)
+						addOwed(owed, resource, player.ID, 1)
 					}
 				}
 				cities := playerState.GetCities()
 				if utils.SliceContains(cities, vertice) {
 					if tile.Blocked {
-						state.bookKeeping.AddResourcesBlocked(player.ID, tile.Resource, 2)
+						blocked[player.ID] = blocked[player.ID].Add(coreT.ResourcesOf(resource, 2))
 					} else {
-						playerState.AddResource(tile.Resource, 2)
-						state.bookKeeping.AddResourceDrawn(player.ID, tile.Resource, 2)
+						addOwed(owed, resource, player.ID, 2)
 					}
 				}
 			}
 		}
 	}
-	state.round.SetRoundType(round.Regular)
-	return nil
+	for _, player := range state.players {
+		if resources, exists := blocked[player.ID]; exists {
+			state.bookKeeping.AddResourcesBlocked(player.ID, resources)
+			state.emit(ResourcesBlocked{PlayerID: player.ID, Resources: resources})
+		}
+	}
+	state.payFromBank(owed)
 }
 
 func (state *GameState) handle7() {
+	if state.IsRobbingSuspended() {
+		state.round.SetRoundType(round.MoveRobberDue7)
+		return
+	}
+
 	shouldMoveToDiscardPhase := false
 	for _, player := range state.players {
 		toDiscard := state.discardAmountByPlayer(player.ID)
@@ -136,12 +188,19 @@ func (state *GameState) handle7() {
 	}
 }
 
-func (state *GameState) EndRound(playerID string) error {
+func (state *GameState) EndRound(playerID string) (err error) {
+	defer state.record(Command{Type: CommandEndRound, PlayerID: playerID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot end round during other player's round")
 		return err
 	}
 
+	if state.round.GetRoundType() == round.SpecialBuild {
+		state.endSpecialBuild()
+		return nil
+	}
+
 	if state.round.GetRoundType() != round.Regular {
 		err := fmt.Errorf("Cannot end round during %s", state.round.GetCurrentRoundTypeDescription())
 		return err
@@ -155,10 +214,12 @@ func (state *GameState) EndRound(playerID string) error {
 
 	state.round.IncrementRound()
 	state.round.SetDice(0, 0)
+	state.round.SetSecondDice(0, 0)
 	for _, player := range state.players {
 		playerState := state.playersStates[player.ID]
 		playerState.SetHasDiscardedThisTurn(false)
 		playerState.ResetNumberOfDevCardsPlayedCurrentTurn()
+		playerState.SetHasMovedShipThisTurn(false)
 		playerState.SetDiscardAmount(0)
 	}
 	newIndex := state.currentPlayerIndex + 1
@@ -168,8 +229,26 @@ func (state *GameState) EndRound(playerID string) error {
 	state.currentPlayerIndex = newIndex
 	state.bookKeeping.AddPointsRecord(state.points)
 	state.bookKeeping.AddLongestRoadRecord(state.LongestRoadLengths())
-	state.round.SetRoundType(round.BetweenTurns)
-
 	state.trade.CancelActiveTrades()
+
+	if state.specialBuildPhase && len(state.players) > 1 {
+		state.round.SetSpecialBuildersLeft(len(state.players) - 1)
+		state.round.SetRoundType(round.SpecialBuild)
+		return nil
+	}
+	state.round.SetRoundType(round.BetweenTurns)
 	return nil
 }
+
+// endSpecialBuild passes the building window to the next player.
+// Once everyone but the player whose turn ended has built, the player after them rolls
+func (state *GameState) endSpecialBuild() {
+	buildersLeft := state.round.GetSpecialBuildersLeft() - 1
+	state.round.SetSpecialBuildersLeft(buildersLeft)
+	if buildersLeft > 0 {
+		state.currentPlayerIndex = (state.currentPlayerIndex + 1) % len(state.players)
+		return
+	}
+	state.currentPlayerIndex = (state.currentPlayerIndex + 2) % len(state.players)
+	state.round.SetRoundType(round.BetweenTurns)
+}
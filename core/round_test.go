@@ -2,10 +2,10 @@ package core
 
 import (
 	"fmt"
-	"maps"
 	"testing"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestRollDiceNotPlayerRound(t *testing.T) {
@@ -70,13 +70,13 @@ func TestHandleDiceNot7NotBlocked(t *testing.T) {
 			MockWithCitiesByPlayer(map[string][]int{
 				"1": {32},
 			}),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 			}),
 			MockWithRand(rand),
@@ -86,106 +86,106 @@ func TestHandleDiceNot7NotBlocked(t *testing.T) {
 
 	var tests = []struct {
 		dice           int
-		resourcesAfter map[string]int
+		resourcesAfter coreT.Resources
 	}{
 		{
 			dice: 2,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  3,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  3,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 3,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  2,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  2,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 4,
-			resourcesAfter: map[string]int{
-				"Lumber": 3,
-				"Brick":  2,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 3,
+				Brick:  2,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 5,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  2,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  2,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 6,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 8,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 9,
-			resourcesAfter: map[string]int{
-				"Lumber": 2,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 2,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 10,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 		{
 			dice: 11,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    2,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    2,
 			},
 		},
 		{
 			dice: 12,
-			resourcesAfter: map[string]int{
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+			resourcesAfter: coreT.Resources{
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		},
 	}
@@ -196,7 +196,7 @@ func TestHandleDiceNot7NotBlocked(t *testing.T) {
 			game := createGame(tt.dice)
 			game.RollDice("1")
 			actualResources := game.ResourceHandByPlayer("1")
-			if !maps.Equal(tt.resourcesAfter, actualResources) {
+			if tt.resourcesAfter != actualResources {
 				t.Errorf("expected %v, got %v", tt.resourcesAfter, actualResources)
 			}
 		})
@@ -213,13 +213,13 @@ func TestHandleDiceNot7Blocked(t *testing.T) {
 		MockWithCitiesByPlayer(map[string][]int{
 			"1": {32},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithBlockedTile(10),
@@ -230,20 +230,20 @@ func TestHandleDiceNot7Blocked(t *testing.T) {
 		game.RollDice("1")
 		actualResources := game.ResourceHandByPlayer("1")
 
-		if actualResources["Lumber"] != 1 {
-			t.Errorf("expected to have 1 Lumber, actually have %d", actualResources["Lumber"])
+		if actualResources.Lumber != 1 {
+			t.Errorf("expected to have 1 Lumber, actually have %d", actualResources.Lumber)
 		}
-		if actualResources["Brick"] != 2 {
-			t.Errorf("expected to have 2 Brick, actually have %d", actualResources["Brick"])
+		if actualResources.Brick != 2 {
+			t.Errorf("expected to have 2 Brick, actually have %d", actualResources.Brick)
 		}
-		if actualResources["Sheep"] != 1 {
-			t.Errorf("expected to have 1 Sheep, actually have %d", actualResources["Sheep"])
+		if actualResources.Sheep != 1 {
+			t.Errorf("expected to have 1 Sheep, actually have %d", actualResources.Sheep)
 		}
-		if actualResources["Grain"] != 1 {
-			t.Errorf("expected to have 1 Grain, actually have %d", actualResources["Grain"])
+		if actualResources.Grain != 1 {
+			t.Errorf("expected to have 1 Grain, actually have %d", actualResources.Grain)
 		}
-		if actualResources["Ore"] != 1 {
-			t.Errorf("expected to have 1 Ore, actually have %d", actualResources["Ore"])
+		if actualResources.Ore != 1 {
+			t.Errorf("expected to have 1 Ore, actually have %d", actualResources.Ore)
 		}
 	})
 }
@@ -0,0 +1,336 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/board"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Scenario describes a position to start a game from instead of the setup phase:
+// tutorials, puzzles, reproductions of rules disputes, QA setups...
+// Players are referred to by seat (0 plays first), so the same scenario fits any room of the right size
+type Scenario struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Map         string `json:"map"`
+	// Tiles in tile ID order. Left empty, the board is generated as usual
+	Tiles []ScenarioTile `json:"tiles"`
+	// Ports in the order of the map's ports locations. Left empty, ports are generated as usual
+	Ports []string `json:"ports"`
+	// ID of the tile the robber is on. Left as 0, the robber starts on the desert
+	Robber        int    `json:"robber"`
+	CurrentPlayer int    `json:"currentPlayer"`
+	RoundType     string `json:"roundType"`
+	RoundNumber   int    `json:"roundNumber"`
+	// Set only for Regular rounds, whose dice were already rolled
+	Dice    [2]int           `json:"dice"`
+	Players []ScenarioPlayer `json:"players"`
+}
+
+type ScenarioTile struct {
+	Resource string `json:"resource"`
+	Token    int    `json:"token"`
+}
+
+type ScenarioPlayer struct {
+	Settlements []int           `json:"settlements"`
+	Cities      []int           `json:"cities"`
+	Roads       []int           `json:"roads"`
+	Resources   coreT.Resources `json:"resources"`
+	// Development cards in hand by name, all of them playable right away
+	DevelopmentCards map[string]int `json:"developmentCards"`
+	KnightsUsed      int            `json:"knightsUsed"`
+}
+
+// Scenarios can only start at the beginning of a round, either before or after the dice are rolled
+var scenarioRoundTypes = map[string]round.Type{
+	"BetweenTurns": round.BetweenTurns,
+	"Regular":      round.Regular,
+}
+
+// ParseScenario decodes a scenario, rejecting unknown fields so typos don't go unnoticed
+func ParseScenario(data []byte) (*Scenario, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var scenario Scenario
+	if err := decoder.Decode(&scenario); err != nil {
+		err := fmt.Errorf("Cannot parse scenario: %w", err)
+		return nil, err
+	}
+	return &scenario, nil
+}
+
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err := fmt.Errorf("Cannot load scenario: %w", err)
+		return nil, err
+	}
+	return ParseScenario(data)
+}
+
+// NewFromScenario works like NewWithSeed, but the game starts at the position described by scenario.
+// Positions no sequence of valid moves can reach are rejected
+func (state *GameState) NewFromScenario(players []*coreT.Player, scenario *Scenario, seed int64, params Params) error {
+	if params.TwoPlayer == 1 {
+		err := fmt.Errorf("Cannot load scenario: the two-player variant is not supported")
+		return err
+	}
+	if len(scenario.Players) != len(players) {
+		err := fmt.Errorf("Cannot load scenario: made for %d players, but got %d", len(scenario.Players), len(players))
+		return err
+	}
+
+	err := state.NewWithSeed(players, scenario.Map, seed, params)
+	if err != nil {
+		return err
+	}
+
+	snapshot := state.snapshot()
+	err = applyScenario(snapshot, state.board.Definition, scenario)
+	if err != nil {
+		err := fmt.Errorf("Cannot load scenario: %w", err)
+		return err
+	}
+	*state = *restore(snapshot, state.board.Definition, state.randSource)
+
+	for _, player := range state.players {
+		state.computeLongestRoad(player.ID)
+	}
+	state.recountLongestRoad()
+	state.recountKnights()
+	state.updatePoints()
+	if state.round.GetRoundType() == round.GameOver {
+		err := fmt.Errorf("Cannot load scenario: a player already has %d points", state.targetPoint)
+		return err
+	}
+
+	if violations := state.Validate(); violations != nil {
+		err := fmt.Errorf("Cannot load scenario: %w", violations)
+		return err
+	}
+	return nil
+}
+
+func applyScenario(snapshot *Snapshot, definitions *coreMaps.MapDefinition, scenario *Scenario) error {
+	err := applyScenarioBoard(&snapshot.Board, definitions, scenario)
+	if err != nil {
+		return err
+	}
+
+	roundType, exists := scenarioRoundTypes[scenario.RoundType]
+	if scenario.RoundType == "" {
+		roundType, exists = round.BetweenTurns, true
+	}
+	if !exists {
+		err := fmt.Errorf("Unsupported round type '%s'", scenario.RoundType)
+		return err
+	}
+	dice := scenario.Dice
+	if roundType == round.Regular && (dice[0] < 1 || dice[0] > 6 || dice[1] < 1 || dice[1] > 6) {
+		err := fmt.Errorf("Invalid dice %v for a round whose dice were rolled", dice)
+		return err
+	}
+	if roundType == round.BetweenTurns && dice != [2]int{} {
+		err := fmt.Errorf("Cannot have dice %v before they are rolled", dice)
+		return err
+	}
+	// Cards in hand count as bought in the previous round, so they are playable
+	roundNumber := max(scenario.RoundNumber, 1)
+	snapshot.Round = round.Snapshot{Dice: dice, RoundNumber: roundNumber, RoundType: roundType}
+
+	if scenario.CurrentPlayer < 0 || scenario.CurrentPlayer >= len(snapshot.Players) {
+		err := fmt.Errorf("Invalid current player seat %d", scenario.CurrentPlayer)
+		return err
+	}
+	snapshot.CurrentPlayerIndex = scenario.CurrentPlayer
+
+	settings := snapshot.Settings
+	occupied := make(map[int]bool)
+	built := make(map[int]bool)
+	for seat, scenarioPlayer := range scenario.Players {
+		playerID := snapshot.Players[seat].ID
+		playerSnapshot := snapshot.PlayersStates[playerID]
+
+		if len(scenarioPlayer.Settlements) > settings.MaxSettlements {
+			err := fmt.Errorf("Player at seat %d has %d settlements, but the maximum is %d", seat, len(scenarioPlayer.Settlements), settings.MaxSettlements)
+			return err
+		}
+		if len(scenarioPlayer.Cities) > settings.MaxCities {
+			err := fmt.Errorf("Player at seat %d has %d cities, but the maximum is %d", seat, len(scenarioPlayer.Cities), settings.MaxCities)
+			return err
+		}
+		if len(scenarioPlayer.Roads) > settings.MaxRoads {
+			err := fmt.Errorf("Player at seat %d has %d roads, but the maximum is %d", seat, len(scenarioPlayer.Roads), settings.MaxRoads)
+			return err
+		}
+
+		buildings := map[string][]int{"settlement": scenarioPlayer.Settlements, "city": scenarioPlayer.Cities}
+		for _, kind := range []string{"settlement", "city"} {
+			for _, vertexID := range buildings[kind] {
+				if _, exists := definitions.TilesByVertex[vertexID]; !exists {
+					err := fmt.Errorf("Unknown vertex#%d", vertexID)
+					return err
+				}
+				if occupied[vertexID] {
+					err := fmt.Errorf("Vertex#%d has more than one building", vertexID)
+					return err
+				}
+				occupied[vertexID] = true
+
+				building := board.Building{ID: vertexID, Owner: playerID}
+				if kind == "settlement" {
+					snapshot.Board.Settlements[vertexID] = building
+					playerSnapshot.Settlements = append(playerSnapshot.Settlements, vertexID)
+				} else {
+					snapshot.Board.Cities[vertexID] = building
+					playerSnapshot.Cities = append(playerSnapshot.Cities, vertexID)
+				}
+				if port, isPort := snapshot.Board.Ports[vertexID]; isPort {
+					playerSnapshot.Ports = append(playerSnapshot.Ports, vertexID)
+					playerSnapshot.PortsTypes = append(playerSnapshot.PortsTypes, port)
+				}
+			}
+		}
+
+		for _, edgeID := range scenarioPlayer.Roads {
+			if _, exists := definitions.VerticesByEdge[edgeID]; !exists {
+				err := fmt.Errorf("Unknown edge#%d", edgeID)
+				return err
+			}
+			if built[edgeID] {
+				err := fmt.Errorf("Edge#%d has more than one road", edgeID)
+				return err
+			}
+			built[edgeID] = true
+			snapshot.Board.Roads[edgeID] = board.Building{ID: edgeID, Owner: playerID}
+			playerSnapshot.Roads = append(playerSnapshot.Roads, edgeID)
+		}
+
+		hand := scenarioPlayer.Resources
+		if !snapshot.Bank.Resources.Covers(hand) {
+			err := fmt.Errorf("Bank has only %s, cannot give %s to player at seat %d", snapshot.Bank.Resources, hand, seat)
+			return err
+		}
+		snapshot.Bank.Resources = snapshot.Bank.Resources.Subtract(hand)
+		playerSnapshot.Resources = hand
+		snapshot.BookKeeping.ResourcesDrawnByPlayer[playerID] = hand
+
+		for _, name := range slices.Sorted(maps.Keys(scenarioPlayer.DevelopmentCards)) {
+			for i := 0; i < scenarioPlayer.DevelopmentCards[name]; i++ {
+				if err := drawScenarioCard(snapshot, playerID, name); err != nil {
+					return err
+				}
+				playerSnapshot.DevelopmentCards[name] = append(playerSnapshot.DevelopmentCards[name], coreT.DevelopmentCardSnapshot{
+					Name:        name,
+					RoundBought: roundNumber - 1,
+				})
+			}
+		}
+		for i := 0; i < scenarioPlayer.KnightsUsed; i++ {
+			if err := drawScenarioCard(snapshot, playerID, "Knight"); err != nil {
+				return err
+			}
+		}
+		if scenarioPlayer.KnightsUsed > 0 {
+			playerSnapshot.UsedDevelopmentCards["Knight"] = scenarioPlayer.KnightsUsed
+		}
+
+		snapshot.PlayersStates[playerID] = playerSnapshot
+	}
+	return nil
+}
+
+func applyScenarioBoard(boardSnapshot *board.Snapshot, definitions *coreMaps.MapDefinition, scenario *Scenario) error {
+	robber := scenario.Robber
+	if len(scenario.Tiles) > 0 || len(scenario.Ports) > 0 || robber != 0 {
+		// The board is set up by hand, so it may not fit the map deck a code is checked against
+		boardSnapshot.Code = ""
+	}
+	if len(scenario.Tiles) > 0 {
+		if len(scenario.Tiles) != len(boardSnapshot.Tiles) {
+			err := fmt.Errorf("Has %d tiles, but map %s has %d", len(scenario.Tiles), boardSnapshot.MapName, len(boardSnapshot.Tiles))
+			return err
+		}
+		resources := make([]string, 0, len(definitions.Resources))
+		for _, entry := range definitions.Resources {
+			resources = append(resources, entry.Name)
+		}
+		for i, tile := range scenario.Tiles {
+			tileID := boardSnapshot.Tiles[i].ID
+			if !slices.Contains(resources, tile.Resource) {
+				err := fmt.Errorf("Unknown resource '%s' at tile#%d", tile.Resource, tileID)
+				return err
+			}
+			if tile.Resource == "Desert" && tile.Token != 0 {
+				err := fmt.Errorf("Desert at tile#%d cannot have token %d", tileID, tile.Token)
+				return err
+			}
+			if tile.Resource != "Desert" && (tile.Token < 2 || tile.Token > 12 || tile.Token == 7) {
+				err := fmt.Errorf("Invalid token %d at tile#%d", tile.Token, tileID)
+				return err
+			}
+			boardSnapshot.Tiles[i].Resource = tile.Resource
+			boardSnapshot.Tiles[i].Token = tile.Token
+			if robber == 0 && tile.Resource == "Desert" {
+				robber = tileID
+			}
+		}
+	}
+
+	if robber != 0 {
+		found := false
+		for i := range boardSnapshot.Tiles {
+			boardSnapshot.Tiles[i].Blocked = boardSnapshot.Tiles[i].ID == robber
+			if boardSnapshot.Tiles[i].Blocked {
+				boardSnapshot.RobberLocation = i
+				found = true
+			}
+		}
+		if !found {
+			err := fmt.Errorf("Cannot place robber: unknown tile#%d", robber)
+			return err
+		}
+	}
+
+	if len(scenario.Ports) > 0 {
+		if len(scenario.Ports) != len(definitions.PortsLocations) {
+			err := fmt.Errorf("Has %d ports, but map %s has %d", len(scenario.Ports), boardSnapshot.MapName, len(definitions.PortsLocations))
+			return err
+		}
+		for i, port := range scenario.Ports {
+			if _, exists := definitions.PortsByDefinition[port]; !exists {
+				err := fmt.Errorf("Unknown port '%s'", port)
+				return err
+			}
+			for _, vertexID := range definitions.PortsLocations[i] {
+				boardSnapshot.Ports[vertexID] = port
+			}
+		}
+	}
+	return nil
+}
+
+// drawScenarioCard hands the next card named name in the deck to playerID, so the deck stays consistent
+func drawScenarioCard(snapshot *Snapshot, playerID, name string) error {
+	deck := &snapshot.Development
+	for i := deck.NextCardIndex; i < len(deck.Cards); i++ {
+		if deck.Cards[i].Name != name {
+			continue
+		}
+		deck.Cards[i], deck.Cards[deck.NextCardIndex] = deck.Cards[deck.NextCardIndex], deck.Cards[i]
+		deck.NextCardIndex++
+		snapshot.BookKeeping.DevCardsDrawnByPlayer[playerID][name]++
+		return nil
+	}
+	err := fmt.Errorf("Not enough %s cards in the deck", name)
+	return err
+}
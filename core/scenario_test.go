@@ -0,0 +1,185 @@
+package core
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	mapsdefinitions "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+const testScenario = `{
+	"name": "Knights race",
+	"map": "base4",
+	"robber": 5,
+	"currentPlayer": 0,
+	"roundType": "Regular",
+	"roundNumber": 12,
+	"dice": [2, 3],
+	"players": [
+		{
+			"settlements": [1],
+			"cities": [31],
+			"roads": [1, 2, 7, 8, 12],
+			"resources": {"Lumber": 1, "Brick": 1},
+			"developmentCards": {"Knight": 1},
+			"knightsUsed": 3
+		},
+		{
+			"settlements": [24],
+			"roads": [30],
+			"resources": {"Ore": 3}
+		},
+		{},
+		{}
+	]
+}`
+
+func createScenarioGame(scenario *Scenario) (*GameState, error) {
+	mapsdefinitions.LoadMap("base4")
+	players := make([]*coreT.Player, 4)
+	for i := range players {
+		players[i] = &coreT.Player{
+			ID: strconv.FormatInt(int64(i+1), 10),
+			Color: coreT.PlayerColor{
+				Background: "bg",
+				Foreground: "fg",
+			},
+		}
+	}
+	var game GameState
+	err := game.NewFromScenario(players, scenario, 42, seededTestParams)
+	return &game, err
+}
+
+func TestScenario(t *testing.T) {
+	scenario, err := ParseScenario([]byte(testScenario))
+	if err != nil {
+		t.Fatalf("expected to parse scenario just fine, but actually got error %s", err.Error())
+	}
+	game, err := createScenarioGame(scenario)
+	if err != nil {
+		t.Fatalf("expected to load scenario just fine, but actually got error %s", err.Error())
+	}
+
+	t.Run("scenario - position", func(t *testing.T) {
+		if game.RoundType() != round.Regular || game.CurrentRoundPlayer().ID != "1" {
+			t.Errorf("expected player#1 to be playing a regular round, actually got player#%s during %d", game.CurrentRoundPlayer().ID, game.RoundType())
+		}
+		if !slices.Equal(game.BlockedTiles(), []int{5}) {
+			t.Errorf("expected robber to be at tile#5, actually got blocked tiles %v", game.BlockedTiles())
+		}
+		if game.ResourceHandByPlayer("2").Ore != 3 || game.BankResources().Ore != 16 {
+			t.Errorf("expected player#2 to hold 3 Ore out of the bank, actually got %d in hand and %d in the bank", game.ResourceHandByPlayer("2").Ore, game.BankResources().Ore)
+		}
+		// settlement + city + longest road + most knights
+		if game.Points()["1"] != 7 {
+			t.Errorf("expected player#1 to have 7 points, actually got %d", game.Points()["1"])
+		}
+	})
+
+	t.Run("scenario - game goes on", func(t *testing.T) {
+		err := game.UseDevelopmentCard("1", "Knight")
+		if err != nil {
+			t.Errorf("expected to use knight just fine, but actually got error %s", err.Error())
+		}
+		if game.NumberOfKnightsUsedByPlayer("1") != 4 {
+			t.Errorf("expected player#1 to have used 4 knights, actually got %d", game.NumberOfKnightsUsedByPlayer("1"))
+		}
+		if err := game.Validate(); err != nil {
+			t.Errorf("expected state to be valid, but actually got error %s", err.Error())
+		}
+	})
+}
+
+func TestScenarioTiles(t *testing.T) {
+	scenario, _ := ParseScenario([]byte(testScenario))
+	scenario.Robber = 0
+	scenario.Tiles = make([]ScenarioTile, 19)
+	for i := range scenario.Tiles {
+		scenario.Tiles[i] = ScenarioTile{Resource: "Grain", Token: 6}
+	}
+	scenario.Tiles[9] = ScenarioTile{Resource: "Desert"}
+
+	game, err := createScenarioGame(scenario)
+	if err != nil {
+		t.Fatalf("expected to load scenario just fine, but actually got error %s", err.Error())
+	}
+	for _, tile := range game.GetBoard() {
+		expected := "Grain"
+		if tile.ID == 10 {
+			expected = "Desert"
+		}
+		if tile.Resource != expected {
+			t.Errorf("expected tile#%d to be %s, actually got %s", tile.ID, expected, tile.Resource)
+		}
+	}
+	if !slices.Equal(game.BlockedTiles(), []int{10}) {
+		t.Errorf("expected robber to start on the desert, actually got blocked tiles %v", game.BlockedTiles())
+	}
+}
+
+func TestScenarioErrors(t *testing.T) {
+	var tests = []struct {
+		description string
+		modify      func(scenario *Scenario)
+	}{
+		{
+			description: "players count doesn't match",
+			modify:      func(scenario *Scenario) { scenario.Players = scenario.Players[:3] },
+		},
+		{
+			description: "distance rule",
+			modify:      func(scenario *Scenario) { scenario.Players[1].Settlements = []int{24, 2} },
+		},
+		{
+			description: "disconnected road",
+			modify:      func(scenario *Scenario) { scenario.Players[1].Roads = []int{40} },
+		},
+		{
+			description: "bank shortage",
+			modify:      func(scenario *Scenario) { scenario.Players[2].Resources = coreT.Resources{Ore: 17} },
+		},
+		{
+			description: "too many knights",
+			modify:      func(scenario *Scenario) { scenario.Players[2].KnightsUsed = 14 },
+		},
+		{
+			description: "unsupported round type",
+			modify:      func(scenario *Scenario) { scenario.RoundType = "DiscardPhase" },
+		},
+		{
+			description: "dice not rolled",
+			modify:      func(scenario *Scenario) { scenario.Dice = [2]int{} },
+		},
+		{
+			description: "desert with token",
+			modify: func(scenario *Scenario) {
+				scenario.Tiles = make([]ScenarioTile, 19)
+				for i := range scenario.Tiles {
+					scenario.Tiles[i] = ScenarioTile{Resource: "Desert", Token: 8}
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("scenario - "+tt.description, func(t *testing.T) {
+			scenario, _ := ParseScenario([]byte(testScenario))
+			tt.modify(scenario)
+			_, err := createScenarioGame(scenario)
+			if err == nil {
+				t.Errorf("expected to not be able to load scenario, but actually loaded just fine")
+			}
+		})
+	}
+
+	t.Run("scenario - unknown field", func(t *testing.T) {
+		_, err := ParseScenario([]byte(`{"map": "base4", "robbr": 5}`))
+		if err == nil {
+			t.Errorf("expected to not be able to parse scenario with unknown field, but actually parsed just fine")
+		}
+	})
+}
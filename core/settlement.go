@@ -2,34 +2,41 @@ package core
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
-func (state *GameState) BuildSettlement(playerID string, vertexID int) error {
+func (state *GameState) BuildSettlement(playerID string, vertexID int) (err error) {
+	defer state.record(Command{Type: CommandBuildSettlement, PlayerID: playerID, VertexID: vertexID})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot build settlement during other player's turn")
 		return err
 	}
 
 	roundType := state.round.GetRoundType()
-	if roundType != round.SetupSettlement1 && roundType != round.SetupSettlement2 && roundType != round.Regular {
+	if roundType != round.SetupSettlement1 && roundType != round.SetupSettlement2 && !state.isBuildingRound() {
 		err := fmt.Errorf("Cannot build settlement during %s", state.round.GetCurrentRoundTypeDescription())
 		return err
 	}
 
+	if _, onLand := state.board.Definition.TilesByVertex[vertexID]; !onLand {
+		err := fmt.Errorf("Cannot build settlement at vertex #%d: it isn't on land", vertexID)
+		return err
+	}
+
 	vertice, exists := state.board.GetSettlements()[vertexID]
 	if exists {
-		owner := state.findPlayer(vertice.Owner)
-		err := fmt.Errorf("Player %s already has settlement at vertex #%d", owner.ID, vertexID)
+		err := fmt.Errorf("Player %s already has settlement at vertex #%d", vertice.Owner, vertexID)
 		return err
 	}
 
 	vertice, exists = state.board.GetCities()[vertexID]
 	if exists {
-		owner := state.findPlayer(vertice.Owner)
-		err := fmt.Errorf("Player %s already has city at vertex #%d", owner.ID, vertexID)
+		err := fmt.Errorf("Player %s already has city at vertex #%d", vertice.Owner, vertexID)
 		return err
 	}
 
@@ -44,14 +51,13 @@ func (state *GameState) BuildSettlement(playerID string, vertexID int) error {
 		return nil
 	}
 
-	if !state.ownsRoadApproaching(playerID, vertexID) {
-		err := fmt.Errorf("Cannot build at vertex %d since it doesn't have a road attached to it", vertexID)
+	if !state.ownsRoadApproaching(playerID, vertexID) && !state.ownsShipApproaching(playerID, vertexID) {
+		err := fmt.Errorf("Cannot build at vertex %d since it doesn't have a road or ship attached to it", vertexID)
 		return err
 	}
 
 	playerState := state.playersStates[playerID]
-	resources := playerState.GetResources()
-	if resources["Lumber"] < 1 || resources["Brick"] < 1 || resources["Grain"] < 1 || resources["Sheep"] < 1 {
+	if !playerState.GetResources().Covers(coreT.SettlementCost) {
 		err := fmt.Errorf("Insufficient resources to build a settlement")
 		return err
 	}
@@ -62,15 +68,11 @@ func (state *GameState) BuildSettlement(playerID string, vertexID int) error {
 		return err
 	}
 
-	playerState.RemoveResource("Lumber", 1)
-	playerState.RemoveResource("Brick", 1)
-	playerState.RemoveResource("Sheep", 1)
-	playerState.RemoveResource("Grain", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Lumber", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Brick", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Sheep", 1)
-	state.bookKeeping.AddResourcesUsed(playerID, "Grain", 1)
+	state.payToBank(playerID, coreT.SettlementCost)
 	state.handleNewSettlement(playerID, vertexID)
+	if state.twoPlayer {
+		state.growNeutralRoad()
+	}
 
 	return nil
 }
@@ -83,6 +85,11 @@ func (state *GameState) handleNewSettlement(playerID string, vertexID int) {
 	if isPort {
 		state.playersStates[playerID].AddPort(vertexID, port)
 	}
+	state.emit(SettlementBuilt{PlayerID: playerID, VertexID: vertexID})
+	state.exploreAroundVertex(playerID, vertexID)
+	if state.twoPlayer {
+		state.awardTradeTokens(playerID, vertexID)
+	}
 
 	// Building a settlement may halt a path
 	// OPTIMIZE: check adjacent roads to vertexID and only recalculate for affected players
@@ -100,7 +107,7 @@ func (state *GameState) AvailableVertices(playerID string) ([]int, error) {
 	}
 
 	roundType := state.round.GetRoundType()
-	if roundType != round.SetupSettlement1 && roundType != round.SetupSettlement2 && roundType != round.Regular {
+	if roundType != round.SetupSettlement1 && roundType != round.SetupSettlement2 && !state.isBuildingRound() {
 		err := fmt.Errorf("Cannot check available vertices during %s", state.round.GetCurrentRoundTypeDescription())
 		return []int{}, err
 	}
@@ -127,11 +134,13 @@ func (state *GameState) AvailableVertices(playerID string) ([]int, error) {
 	}
 
 	vertexSet := utils.NewSet[int]()
-	for _, edgeID := range state.playersStates[playerID].GetRoads() {
+	playerState := state.playersStates[playerID]
+	for _, edgeID := range append(slices.Clone(playerState.GetRoads()), playerState.GetShips()...) {
 		for _, vertexID := range state.board.Definition.VerticesByEdge[edgeID] {
 			_, settlementExists := settlements[vertexID]
 			_, cityExists := cities[vertexID]
-			if settlementExists || cityExists {
+			_, onLand := state.board.Definition.TilesByVertex[vertexID]
+			if settlementExists || cityExists || !onLand {
 				continue
 			}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/victoroliveirab/settlers/core/packages/board"
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
@@ -26,13 +27,13 @@ func TestBuildSettlementRegularPhaseSuccess(t *testing.T) {
 			"1": {65},
 		},
 		),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -57,20 +58,20 @@ func TestBuildSettlementRegularPhaseSuccess(t *testing.T) {
 
 		player1ResourcesAfterBuild := game.ResourceHandByPlayer("1")
 
-		if player1ResourcesAfterBuild["Lumber"] != 3 {
-			t.Errorf("expected to have 3 Lumber after build settlement, but found %d", player1ResourcesAfterBuild["Lumber"])
+		if player1ResourcesAfterBuild.Lumber != 3 {
+			t.Errorf("expected to have 3 Lumber after build settlement, but found %d", player1ResourcesAfterBuild.Lumber)
 		}
 
-		if player1ResourcesAfterBuild["Brick"] != 2 {
-			t.Errorf("expected to have 2 Brick after build settlement, but found %d", player1ResourcesAfterBuild["Brick"])
+		if player1ResourcesAfterBuild.Brick != 2 {
+			t.Errorf("expected to have 2 Brick after build settlement, but found %d", player1ResourcesAfterBuild.Brick)
 		}
 
-		if player1ResourcesAfterBuild["Sheep"] != 1 {
-			t.Errorf("expected to have 1 Sheep after build settlement, but found %d", player1ResourcesAfterBuild["Sheep"])
+		if player1ResourcesAfterBuild.Sheep != 1 {
+			t.Errorf("expected to have 1 Sheep after build settlement, but found %d", player1ResourcesAfterBuild.Sheep)
 		}
 
-		if player1ResourcesAfterBuild["Grain"] != 0 {
-			t.Errorf("expected to have 0 Grain after build settlement, but found %d", player1ResourcesAfterBuild["Grain"])
+		if player1ResourcesAfterBuild.Grain != 0 {
+			t.Errorf("expected to have 0 Grain after build settlement, but found %d", player1ResourcesAfterBuild.Grain)
 		}
 	})
 }
@@ -85,13 +86,13 @@ func TestBuildSettlementErrorAlreadyExistsByPlayer(t *testing.T) {
 			"1": {65},
 		},
 		),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -113,13 +114,13 @@ func TestBuildSettlementErrorAlreadyExistsOtherPlayer(t *testing.T) {
 			"1": {65},
 		},
 		),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -139,13 +140,13 @@ func TestBuildSettlementErrorNotPlayerRound(t *testing.T) {
 			"1": {65},
 		},
 		),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -164,13 +165,13 @@ func TestBuildSettlementErrorNotEnoughResources(t *testing.T) {
 			"1": {65},
 		},
 		),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 0,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 0,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -190,13 +191,13 @@ func TestBuildSettlementErrorNotAppropriateRound(t *testing.T) {
 			"1": {65},
 		},
 		),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -218,13 +219,13 @@ func TestBuildSettlementErrorAlreadyExistsCity(t *testing.T) {
 		MockWithCitiesByPlayer(map[string][]int{
 			"2": {42},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -239,13 +240,13 @@ func TestBuildSettlementErrorAlreadyExistsCity(t *testing.T) {
 func TestBuildSettlementErrorBuildingWithoutRoad(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
@@ -267,13 +268,13 @@ func TestBuildSettlementErrorAlreadyBuildingSameEdge(t *testing.T) {
 		MockWithSettlementsByPlayer(map[string][]int{
 			"2": {31},
 		}),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  2,
-				"Grain":  1,
-				"Ore":    0,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  2,
+				Grain:  1,
+				Ore:    0,
 			},
 		}),
 	)
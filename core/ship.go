@@ -0,0 +1,240 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Ships are the sea counterpart of roads: they sit on sea or coastal edges, forming shipping lines
+// that start at a settlement or city of their owner. Roads and ships only join at a settlement or city.
+
+func (state *GameState) BuildShip(playerID string, edgeID int) (err error) {
+	defer state.record(Command{Type: CommandBuildShip, PlayerID: playerID, EdgeID: edgeID})(&err)
+
+	if playerID != state.currentPlayer().ID {
+		err := fmt.Errorf("Cannot build ship during other player's turn")
+		return err
+	}
+
+	if !state.isBuildingRound() {
+		err := fmt.Errorf("Cannot build ship during %s", state.round.GetCurrentRoundTypeDescription())
+		return err
+	}
+
+	if err := state.checkShipEdge(edgeID); err != nil {
+		return err
+	}
+
+	playerState := state.playersStates[playerID]
+
+	if !playerState.GetResources().Covers(coreT.ShipCost) {
+		err := fmt.Errorf("Insufficient resources to build a ship")
+		return err
+	}
+
+	if playerState.GetNumberOfShips() >= state.maxShips {
+		err := fmt.Errorf("Cannot have more than %d ships at once", state.maxShips)
+		return err
+	}
+
+	if !state.ownsShippingApproaching(playerID, edgeID) {
+		err := fmt.Errorf("Cannot build isolated ship (edge#%d)", edgeID)
+		return err
+	}
+
+	state.payToBank(playerID, coreT.ShipCost)
+	state.board.AddShip(playerID, edgeID)
+	playerState.AddShip(edgeID)
+	state.emit(ShipBuilt{PlayerID: playerID, EdgeID: edgeID})
+	state.exploreAroundEdge(playerID, edgeID)
+
+	state.computeLongestRoad(playerID)
+	changed := state.recountLongestRoad()
+	if changed {
+		state.updatePoints()
+	}
+
+	return nil
+}
+
+// MoveShip moves the ship at the open end of a shipping line to another spot, once per turn
+func (state *GameState) MoveShip(playerID string, fromEdgeID, toEdgeID int) (err error) {
+	defer state.record(Command{Type: CommandMoveShip, PlayerID: playerID, EdgeID: fromEdgeID, SecondEdgeID: toEdgeID})(&err)
+
+	if playerID != state.currentPlayer().ID {
+		err := fmt.Errorf("Cannot move ship during other player's turn")
+		return err
+	}
+
+	if state.round.GetRoundType() != round.Regular {
+		err := fmt.Errorf("Cannot move ship during %s", state.round.GetCurrentRoundTypeDescription())
+		return err
+	}
+
+	playerState := state.playersStates[playerID]
+	if playerState.GetHasMovedShipThisTurn() {
+		err := fmt.Errorf("Cannot move more than one ship per turn")
+		return err
+	}
+
+	ship, exists := state.board.GetShips()[fromEdgeID]
+	if !exists || ship.Owner != playerID {
+		err := fmt.Errorf("Player %s has no ship at edge #%d", playerID, fromEdgeID)
+		return err
+	}
+
+	if !state.isOpenShip(playerID, fromEdgeID) {
+		err := fmt.Errorf("Cannot move ship at edge #%d: it isn't at the open end of a shipping line", fromEdgeID)
+		return err
+	}
+
+	if err := state.checkShipEdge(toEdgeID); err != nil {
+		return err
+	}
+
+	// The ship must reach its new spot from what is left of the line
+	if !state.ownsShippingApproachingWithout(playerID, toEdgeID, fromEdgeID) {
+		err := fmt.Errorf("Cannot move ship to isolated spot (edge#%d)", toEdgeID)
+		return err
+	}
+
+	state.board.RemoveShip(fromEdgeID)
+	playerState.RemoveShip(fromEdgeID)
+	state.board.AddShip(playerID, toEdgeID)
+	playerState.AddShip(toEdgeID)
+	playerState.SetHasMovedShipThisTurn(true)
+	state.emit(ShipMoved{PlayerID: playerID, FromEdgeID: fromEdgeID, ToEdgeID: toEdgeID})
+	state.exploreAroundEdge(playerID, toEdgeID)
+
+	state.computeLongestRoad(playerID)
+	changed := state.recountLongestRoad()
+	if changed {
+		state.updatePoints()
+	}
+
+	return nil
+}
+
+// AvailableShipEdges lists the spots the player can build a ship at, regardless of resources
+func (state *GameState) AvailableShipEdges(playerID string) ([]int, error) {
+	if playerID != state.currentPlayer().ID {
+		err := fmt.Errorf("Cannot check available ship edges during other player's turn")
+		return []int{}, err
+	}
+
+	if !state.isBuildingRound() {
+		err := fmt.Errorf("Cannot check available ship edges during %s", state.round.GetCurrentRoundTypeDescription())
+		return []int{}, err
+	}
+
+	return state.legalShipEdges(playerID), nil
+}
+
+// MovableShips lists the ships of the player at the open end of a shipping line, if it can move one this turn
+func (state *GameState) MovableShips(playerID string) []int {
+	edges := make([]int, 0)
+	if playerID != state.currentPlayer().ID || state.round.GetRoundType() != round.Regular {
+		return edges
+	}
+	playerState := state.playersStates[playerID]
+	if playerState.GetHasMovedShipThisTurn() {
+		return edges
+	}
+	for _, edgeID := range playerState.GetShips() {
+		if state.isOpenShip(playerID, edgeID) {
+			edges = append(edges, edgeID)
+		}
+	}
+	slices.Sort(edges)
+	return edges
+}
+
+func (state *GameState) isShipEdge(edgeID int) bool {
+	return state.board.Definition.CoastalEdges[edgeID] || state.board.Definition.SeaEdges[edgeID]
+}
+
+func (state *GameState) isSeaEdge(edgeID int) bool {
+	return state.board.Definition.SeaEdges[edgeID]
+}
+
+// checkShipEdge checks a ship could sit at edgeID, connections aside
+func (state *GameState) checkShipEdge(edgeID int) error {
+	if !state.isShipEdge(edgeID) {
+		err := fmt.Errorf("Cannot place ship at edge #%d: it is neither on the sea nor on the coast", edgeID)
+		return err
+	}
+	if road, exists := state.board.GetRoads()[edgeID]; exists {
+		err := fmt.Errorf("Player %s already has road at edge #%d", road.Owner, edgeID)
+		return err
+	}
+	if ship, exists := state.board.GetShips()[edgeID]; exists {
+		err := fmt.Errorf("Player %s already has ship at edge #%d", ship.Owner, edgeID)
+		return err
+	}
+	return nil
+}
+
+// ownsShippingApproaching tells whether a ship at edgeID would be connected to a settlement or city of the player,
+// either directly or through a ship not sailing past another player's building
+func (state *GameState) ownsShippingApproaching(playerID string, edgeID int) bool {
+	return state.ownsShippingApproachingWithout(playerID, edgeID, 0)
+}
+
+// ownsShippingApproachingWithout works like ownsShippingApproaching, as if the ship at ignoredEdgeID had left
+func (state *GameState) ownsShippingApproachingWithout(playerID string, edgeID, ignoredEdgeID int) bool {
+	ships := state.board.GetShips()
+	delete(ships, ignoredEdgeID)
+	for _, vertexID := range state.board.Definition.VerticesByEdge[edgeID] {
+		owner, hasBuilding := state.buildingOwnerAt(vertexID)
+		if hasBuilding {
+			if owner == playerID {
+				return true
+			}
+			continue
+		}
+		for _, otherEdgeID := range state.board.Definition.EdgesByVertex[vertexID] {
+			ship, exists := ships[otherEdgeID]
+			if otherEdgeID != edgeID && exists && ship.Owner == playerID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ownsShipApproaching tells whether the player has a ship touching vertexID
+func (state *GameState) ownsShipApproaching(playerID string, vertexID int) bool {
+	ships := state.board.GetShips()
+	for _, edgeID := range state.board.Definition.EdgesByVertex[vertexID] {
+		ship, exists := ships[edgeID]
+		if exists && ship.Owner == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// isOpenShip tells whether one of the ends of the ship at edgeID has neither a building nor another ship of the player
+func (state *GameState) isOpenShip(playerID string, edgeID int) bool {
+	ships := state.board.GetShips()
+	for _, vertexID := range state.board.Definition.VerticesByEdge[edgeID] {
+		if owner, hasBuilding := state.buildingOwnerAt(vertexID); hasBuilding && owner == playerID {
+			continue
+		}
+		continues := false
+		for _, otherEdgeID := range state.board.Definition.EdgesByVertex[vertexID] {
+			ship, exists := ships[otherEdgeID]
+			if otherEdgeID != edgeID && exists && ship.Owner == playerID {
+				continues = true
+				break
+			}
+		}
+		if !continues {
+			return true
+		}
+	}
+	return false
+}
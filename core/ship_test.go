@@ -0,0 +1,221 @@
+package core
+
+import (
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// On islands4, vertex#9 is on the coast of the main island, with coastal edges #8 and #9 and sea edge #118.
+// Edge #126 crosses the sea from vertex#8 to vertex#43, on an island. Edge #10 is inland
+var islands4 = onMap("islands4", 3)
+
+func TestSeaTopology(t *testing.T) {
+	game := createSeededTestGame(t, 42, islands4)
+	definitions := game.board.Definition
+
+	if len(game.GetSeaTiles()) != 18 {
+		t.Errorf("expected islands4 to have 18 sea tiles, actually got %d", len(game.GetSeaTiles()))
+	}
+	for _, tile := range game.GetSeaTiles() {
+		if tile.Resource != coreMaps.SeaResource || tile.Token != 0 {
+			t.Errorf("expected sea tile#%d to have no resource nor token, actually got %s %d", tile.ID, tile.Resource, tile.Token)
+		}
+	}
+
+	expectedKinds := map[int]string{8: "coast", 9: "coast", 118: "sea", 126: "sea", 10: "land"}
+	for edgeID, expected := range expectedKinds {
+		kind := "land"
+		if definitions.SeaEdges[edgeID] {
+			kind = "sea"
+		} else if definitions.CoastalEdges[edgeID] {
+			kind = "coast"
+		}
+		if kind != expected {
+			t.Errorf("expected edge#%d to be on %s, actually got %s", edgeID, expected, kind)
+		}
+	}
+
+	t.Run("maps without sea have no room for ships", func(t *testing.T) {
+		game := CreateTestGame()
+		if len(game.board.Definition.CoastalEdges) != 0 || len(game.board.Definition.SeaEdges) != 0 {
+			t.Errorf("expected base4 to have neither coastal nor sea edges")
+		}
+	})
+}
+
+func TestBuildShip(t *testing.T) {
+	createGame := func() *GameState {
+		return createSeededTestGame(t, 42, islands4, withMocks(
+			MockWithRoundType(round.Regular),
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {9},
+			}),
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
+				"1": {Lumber: 3, Brick: 1, Sheep: 3, Grain: 1},
+			}),
+		))
+	}
+
+	t.Run("ship success", func(t *testing.T) {
+		game := createGame()
+		err := game.BuildShip("1", 8)
+		if err != nil {
+			t.Fatalf("expected to build ship just fine, but actually got error %s", err.Error())
+		}
+		err = game.BuildShip("1", 126)
+		if err != nil {
+			t.Fatalf("expected to extend shipping line just fine, but actually got error %s", err.Error())
+		}
+		expected := coreT.Resources{Lumber: 1, Brick: 1, Sheep: 1, Grain: 1}
+		if game.ResourceHandByPlayer("1") != expected {
+			t.Errorf("expected player 1 hand to be %s, actually got %s", expected, game.ResourceHandByPlayer("1"))
+		}
+		if !utils.SliceEqual(game.ShipsByPlayer("1"), []int{8, 126}) {
+			t.Errorf("expected player 1 to have ships at edges #8 and #126, actually got %v", game.ShipsByPlayer("1"))
+		}
+
+		t.Run("settlement at the end of the shipping line", func(t *testing.T) {
+			err := game.BuildSettlement("1", 43)
+			if err != nil {
+				t.Errorf("expected to build settlement on the island just fine, but actually got error %s", err.Error())
+			}
+		})
+	})
+
+	t.Run("ship inland", func(t *testing.T) {
+		game := createGame()
+		err := game.BuildShip("1", 10)
+		if err == nil {
+			t.Errorf("expected to have error since edge#10 is inland, but actually no error was found")
+		}
+	})
+
+	t.Run("ship isolated", func(t *testing.T) {
+		game := createGame()
+		err := game.BuildShip("1", 126)
+		if err == nil {
+			t.Errorf("expected to have error since edge#126 isn't connected to player 1, but actually no error was found")
+		}
+	})
+
+	t.Run("ship on a road", func(t *testing.T) {
+		game := createGame()
+		MockWithRoadsByPlayer(map[string][]int{"1": {8}})(game)
+		err := game.BuildShip("1", 8)
+		if err == nil {
+			t.Errorf("expected to have error since edge#8 already has a road, but actually no error was found")
+		}
+	})
+
+	t.Run("road at sea", func(t *testing.T) {
+		game := createGame()
+		err := game.BuildRoad("1", 118)
+		if err == nil {
+			t.Errorf("expected to have error since edge#118 is at sea, but actually no error was found")
+		}
+	})
+
+	t.Run("road on a ship", func(t *testing.T) {
+		game := createGame()
+		MockWithShipsByPlayer(map[string][]int{"1": {8}})(game)
+		err := game.BuildRoad("1", 8)
+		if err == nil {
+			t.Errorf("expected to have error since edge#8 already has a ship, but actually no error was found")
+		}
+	})
+}
+
+func TestMoveShip(t *testing.T) {
+	createGame := func() *GameState {
+		return createSeededTestGame(t, 42, islands4, withMocks(
+			MockWithRoundType(round.Regular),
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {9},
+			}),
+			MockWithShipsByPlayer(map[string][]int{
+				"1": {8, 126},
+			}),
+		))
+	}
+
+	t.Run("move ship success", func(t *testing.T) {
+		game := createGame()
+		if !utils.SliceEqual(game.MovableShips("1"), []int{126}) {
+			t.Fatalf("expected only ship at edge#126 to be movable, actually got %v", game.MovableShips("1"))
+		}
+		err := game.MoveShip("1", 126, 118)
+		if err != nil {
+			t.Fatalf("expected to move ship just fine, but actually got error %s", err.Error())
+		}
+		if _, exists := game.GetAllShips()[118]; !exists {
+			t.Errorf("expected ship to be at edge#118")
+		}
+		if _, exists := game.GetAllShips()[126]; exists {
+			t.Errorf("expected ship to have left edge#126")
+		}
+
+		t.Run("only once per turn", func(t *testing.T) {
+			err := game.MoveShip("1", 118, 126)
+			if err == nil {
+				t.Errorf("expected to have error since player 1 already moved a ship this turn, but actually no error was found")
+			}
+		})
+	})
+
+	t.Run("move ship in the middle of a shipping line", func(t *testing.T) {
+		game := createGame()
+		err := game.MoveShip("1", 8, 118)
+		if err == nil {
+			t.Errorf("expected to have error since ship at edge#8 isn't at the open end, but actually no error was found")
+		}
+	})
+
+	t.Run("move ship to an isolated spot", func(t *testing.T) {
+		game := createGame()
+		// Edge#125 is at sea, but far from player 1 shipping line
+		err := game.MoveShip("1", 126, 125)
+		if err == nil {
+			t.Errorf("expected to have error since edge#125 isn't connected to player 1, but actually no error was found")
+		}
+	})
+}
+
+func TestLongestRouteWithShips(t *testing.T) {
+	t.Run("roads and ships join at a settlement", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, islands4, withMocks(
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {9},
+			}),
+			MockWithRoadsByPlayer(map[string][]int{
+				"1": {9, 28},
+			}),
+			MockWithShipsByPlayer(map[string][]int{
+				"1": {8, 126},
+			}),
+		))
+		if game.LongestRoadLengthByPlayer("1") != 4 {
+			t.Errorf("expected player 1 longest route to be 4, actually got %d", game.LongestRoadLengthByPlayer("1"))
+		}
+	})
+
+	t.Run("roads and ships don't join elsewhere", func(t *testing.T) {
+		game := createSeededTestGame(t, 42, islands4, withMocks(
+			MockWithSettlementsByPlayer(map[string][]int{
+				"1": {9},
+			}),
+			MockWithRoadsByPlayer(map[string][]int{
+				"1": {7},
+			}),
+			MockWithShipsByPlayer(map[string][]int{
+				"1": {8, 126},
+			}),
+		))
+		if game.LongestRoadLengthByPlayer("1") != 2 {
+			t.Errorf("expected player 1 longest route to be 2, actually got %d", game.LongestRoadLengthByPlayer("1"))
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package core
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/bank"
+	"github.com/victoroliveirab/settlers/core/packages/board"
+	"github.com/victoroliveirab/settlers/core/packages/book-keeping"
+	"github.com/victoroliveirab/settlers/core/packages/development"
+	"github.com/victoroliveirab/settlers/core/packages/dice"
+	"github.com/victoroliveirab/settlers/core/packages/player"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	"github.com/victoroliveirab/settlers/core/packages/summary"
+	"github.com/victoroliveirab/settlers/core/packages/trade"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/utils"
+)
+
+// SnapshotVersion must be bumped whenever Snapshot changes in a non backwards compatible way
+const SnapshotVersion = 4
+
+type RandSnapshot struct {
+	Seed  int64  `json:"seed"`
+	Draws uint64 `json:"draws"`
+}
+
+type Snapshot struct {
+	Version            int                        `json:"version"`
+	MapName            string                     `json:"mapName"`
+	Rand               RandSnapshot               `json:"rand"`
+	Settings           coreT.Settings             `json:"settings"`
+	GeneralPortCost    int                        `json:"generalPortCost"`
+	ResourcePortCost   int                        `json:"resourcePortCost"`
+	Players            []coreT.Player             `json:"players"`
+	CurrentPlayerIndex int                        `json:"currentPlayerIndex"`
+	Logs               []StateLog                 `json:"logs"`
+	ActionLog          []Command                  `json:"actionLog"`
+	Points             map[string]int             `json:"points"`
+	TradeTokens        map[string]int             `json:"tradeTokens,omitempty"`
+	LongestRoad        LongestRoad                `json:"longestRoad"`
+	MostKnights        MostKnights                `json:"mostKnights"`
+	Bank               bank.Snapshot              `json:"bank"`
+	Board              board.Snapshot             `json:"board"`
+	BookKeeping        bookkeeping.Snapshot       `json:"bookKeeping"`
+	Development        development.Snapshot       `json:"development"`
+	Dice               dice.Snapshot              `json:"dice"`
+	PlayersStates      map[string]player.Snapshot `json:"playersStates"`
+	Round              round.Snapshot             `json:"round"`
+	Trade              trade.Snapshot             `json:"trade"`
+}
+
+func (state *GameState) Snapshot() (*Snapshot, error) {
+	if state.randSource == nil {
+		err := fmt.Errorf("Cannot snapshot game: random generator position is not tracked")
+		return nil, err
+	}
+
+	snapshot := state.snapshot()
+	seed, draws := state.randSource.Position()
+	snapshot.Rand = RandSnapshot{Seed: seed, Draws: draws}
+	return snapshot, nil
+}
+
+// snapshot copies everything but the random generator position
+func (state *GameState) snapshot() *Snapshot {
+	playersStates := make(map[string]player.Snapshot)
+	for playerID, playerState := range state.playersStates {
+		playersStates[playerID] = playerState.Snapshot()
+	}
+
+	return &Snapshot{
+		Version:            SnapshotVersion,
+		MapName:            state.board.MapName,
+		Settings:           state.GetSettings(),
+		GeneralPortCost:    state.generalPortCost,
+		ResourcePortCost:   state.resourcePortCost,
+		Players:            append([]coreT.Player{}, state.players...),
+		CurrentPlayerIndex: state.currentPlayerIndex,
+		Logs:               append([]StateLog{}, state.logs...),
+		ActionLog:          append([]Command{}, state.actionLog...),
+		Points:             maps.Clone(state.points),
+		TradeTokens:        maps.Clone(state.tradeTokens),
+		LongestRoad:        state.longestRoad,
+		MostKnights:        state.mostKnights,
+		Bank:               state.bank.Snapshot(),
+		Board:              state.board.Snapshot(),
+		BookKeeping:        state.bookKeeping.Snapshot(),
+		Development:        state.development.Snapshot(),
+		Dice:               state.dice.Snapshot(),
+		PlayersStates:      playersStates,
+		Round:              state.round.Snapshot(),
+		Trade:              state.trade.Snapshot(),
+	}
+}
+
+func RestoreSnapshot(snapshot *Snapshot) (*GameState, error) {
+	if snapshot.Version != SnapshotVersion {
+		err := fmt.Errorf("Cannot restore snapshot: unsupported version %d (expected %d)", snapshot.Version, SnapshotVersion)
+		return nil, err
+	}
+
+	mapDefinitions, err := coreMaps.GetMapDefinitions(snapshot.MapName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range snapshot.Players {
+		if _, exists := snapshot.PlayersStates[p.ID]; !exists {
+			err := fmt.Errorf("Cannot restore snapshot: missing state of player %s", p.ID)
+			return nil, err
+		}
+	}
+
+	source := utils.RestoreRandSource(snapshot.Rand.Seed, snapshot.Rand.Draws)
+	return restore(snapshot, mapDefinitions, source), nil
+}
+
+func restore(snapshot *Snapshot, mapDefinitions *coreMaps.MapDefinition, source *utils.RandSource) *GameState {
+	settings := snapshot.Settings
+
+	state := &GameState{}
+	state.rand = rand.New(source)
+	state.randSource = source
+	state.bank = bank.FromSnapshot(snapshot.Bank)
+	state.board = board.FromSnapshot(mapDefinitions, snapshot.Board)
+	state.bookKeeping = bookkeeping.FromSnapshot(snapshot.BookKeeping)
+	state.development = development.FromSnapshot(snapshot.Development)
+	state.dice = dice.FromSnapshot(snapshot.Dice)
+	state.round = round.FromSnapshot(snapshot.Round)
+	state.trade = trade.FromSnapshot(state.bookKeeping, state.bank, snapshot.Trade)
+
+	state.logs = append([]StateLog{}, snapshot.Logs...)
+	state.actionLog = append([]Command{}, snapshot.ActionLog...)
+	state.maxCards = settings.MaxCards
+	state.maxSettlements = settings.MaxSettlements
+	state.maxCities = settings.MaxCities
+	state.maxRoads = settings.MaxRoads
+	state.maxShips = settings.MaxShips
+	state.maxDevCardsPerRound = settings.MaxDevCardsPerRound
+	state.bankTradeAmount = settings.BankTradeAmount
+	state.specialBuildPhase = settings.SpecialBuildPhase
+	state.twoPlayer = settings.TwoPlayer
+	state.fogOfWar = settings.FogOfWar
+	state.explorationReward = settings.ExplorationReward
+	state.friendlyRobber = settings.FriendlyRobber
+	state.robberProtectionRounds = settings.RobberProtectionRounds
+	state.pointsPerSettlement = settings.PointsPerSettlement
+	state.pointsPerCity = settings.PointsPerCity
+	state.pointsPerMostKnights = settings.PointsForMostKnights
+	state.pointsPerLongestRoad = settings.PointsForLongestRoad
+	state.mostKnightsMinimum = settings.MostKnightsMinimum
+	state.longestRoadMinimum = settings.LongestRoadMinimum
+	state.targetPoint = settings.TargetPoint
+	state.generalPortCost = snapshot.GeneralPortCost
+	state.resourcePortCost = snapshot.ResourcePortCost
+
+	state.points = maps.Clone(snapshot.Points)
+	if state.points == nil {
+		state.points = make(map[string]int)
+	}
+	state.tradeTokens = maps.Clone(snapshot.TradeTokens)
+	if state.tradeTokens == nil {
+		state.tradeTokens = make(map[string]int)
+	}
+	state.longestRoad = snapshot.LongestRoad
+	state.mostKnights = snapshot.MostKnights
+
+	state.players = append([]coreT.Player{}, snapshot.Players...)
+	state.currentPlayerIndex = snapshot.CurrentPlayerIndex
+	state.playersStates = make(map[string]*player.Instance)
+	for _, p := range state.players {
+		state.playersStates[p.ID] = player.FromSnapshot(snapshot.PlayersStates[p.ID])
+	}
+
+	state.summary = summary.New(
+		state.playersStates,
+		state.GetSettings(),
+		state.bookKeeping,
+	)
+
+	return state
+}
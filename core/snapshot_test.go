@@ -0,0 +1,197 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+
+	mapsdefinitions "github.com/victoroliveirab/settlers/core/maps"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+var seededTestParams = Params{
+	BankTradeAmount:      4,
+	MaxCards:             7,
+	MaxSettlements:       5,
+	MaxCities:            4,
+	MaxRoads:             20,
+	MaxShips:             15,
+	MaxDevCardsPerRound:  1,
+	TargetPoint:          10,
+	PointsPerSettlement:  1,
+	PointsPerCity:        2,
+	PointsForMostKnights: 2,
+	PointsForLongestRoad: 2,
+	LongestRoadMinimum:   5,
+	MostKnightsMinimum:   3,
+}
+
+// seededTestGame describes the game newSeededTestGame creates, base4 with 4 players and seededTestParams by default
+type seededTestGame struct {
+	mapName         string
+	numberOfPlayers int
+	params          Params
+	mocks           []GameStateOption
+}
+
+type seededTestOption func(*seededTestGame)
+
+func onMap(mapName string, numberOfPlayers int) seededTestOption {
+	return func(game *seededTestGame) {
+		game.mapName = mapName
+		game.numberOfPlayers = numberOfPlayers
+	}
+}
+
+func withParams(modify func(params *Params)) seededTestOption {
+	return func(game *seededTestGame) {
+		modify(&game.params)
+	}
+}
+
+// withMocks applies opts once the game is created
+func withMocks(opts ...GameStateOption) seededTestOption {
+	return func(game *seededTestGame) {
+		game.mocks = append(game.mocks, opts...)
+	}
+}
+
+// newSeededTestGame creates a game with NewWithSeed so, unlike CreateTestGame, it can be snapshotted and replayed
+func newSeededTestGame(seed int64, opts ...seededTestOption) (*GameState, error) {
+	config := seededTestGame{mapName: "base4", numberOfPlayers: 4, params: seededTestParams}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	mapsdefinitions.LoadMap(config.mapName)
+	players := make([]*coreT.Player, config.numberOfPlayers)
+	for i := range players {
+		players[i] = &coreT.Player{
+			ID: strconv.FormatInt(int64(i+1), 10),
+			Color: coreT.PlayerColor{
+				Background: "bg",
+				Foreground: "fg",
+			},
+		}
+	}
+	var game GameState
+	err := game.NewWithSeed(players, config.mapName, seed, config.params)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range config.mocks {
+		opt(&game)
+	}
+	return &game, nil
+}
+
+func createSeededTestGame(t testing.TB, seed int64, opts ...seededTestOption) *GameState {
+	game, err := newSeededTestGame(seed, opts...)
+	if err != nil {
+		t.Fatalf("expected to create game just fine, but actually got error %s", err.Error())
+	}
+	return game
+}
+
+func snapshotJSON(t testing.TB, game *GameState) []byte {
+	snapshot, err := game.Snapshot()
+	if err != nil {
+		t.Fatalf("expected to snapshot game just fine, but actually got error %s", err.Error())
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatalf("expected to marshal snapshot just fine, but actually got error %s", err.Error())
+	}
+	return data
+}
+
+// playSetup places the initial settlements and roads of every player using the first available spots
+func playSetup(t testing.TB, game *GameState) {
+	for i := 0; i < 2*len(game.players); i++ {
+		playerID := game.CurrentRoundPlayer().ID
+		vertices, err := game.AvailableVertices(playerID)
+		if err != nil || len(vertices) == 0 {
+			t.Fatalf("expected player#%s to have available vertices during setup", playerID)
+		}
+		if err := game.BuildSettlement(playerID, vertices[0]); err != nil {
+			t.Fatalf("expected player#%s to build settlement just fine, but actually got error %s", playerID, err.Error())
+		}
+		edges, err := game.AvailableEdges(playerID)
+		if err != nil || len(edges) == 0 {
+			t.Fatalf("expected player#%s to have available edges during setup", playerID)
+		}
+		if err := game.BuildRoad(playerID, edges[0]); err != nil {
+			t.Fatalf("expected player#%s to build road just fine, but actually got error %s", playerID, err.Error())
+		}
+	}
+}
+
+func TestSnapshotWithoutTrackedRand(t *testing.T) {
+	game := CreateTestGameWithRand(StubRand(7))
+	_, err := game.Snapshot()
+	if err == nil {
+		t.Errorf("expected to have error since random generator is not tracked, but actually no error was found")
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playSetup(t, game)
+
+	before := snapshotJSON(t, game)
+
+	var decoded Snapshot
+	if err := json.Unmarshal(before, &decoded); err != nil {
+		t.Fatalf("expected to unmarshal snapshot just fine, but actually got error %s", err.Error())
+	}
+	restored, err := RestoreSnapshot(&decoded)
+	if err != nil {
+		t.Fatalf("expected to restore snapshot just fine, but actually got error %s", err.Error())
+	}
+
+	t.Run("restored game has the same snapshot", func(t *testing.T) {
+		after := snapshotJSON(t, restored)
+		if string(before) != string(after) {
+			t.Errorf("expected restored snapshot to be equal to the original one")
+		}
+	})
+
+	t.Run("restored game continues deterministically", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			playerID := game.CurrentRoundPlayer().ID
+			if restored.CurrentRoundPlayer().ID != playerID {
+				t.Fatalf("expected current player to be %s, but it's actually %s", playerID, restored.CurrentRoundPlayer().ID)
+			}
+			errOriginal := game.RollDice(playerID)
+			errRestored := restored.RollDice(playerID)
+			if (errOriginal == nil) != (errRestored == nil) {
+				t.Fatalf("expected both games to behave the same when rolling dice, but got %v and %v", errOriginal, errRestored)
+			}
+			if !reflect.DeepEqual(game.Dice(), restored.Dice()) {
+				t.Fatalf("expected dice to be %v, but it's actually %v", game.Dice(), restored.Dice())
+			}
+			if game.RoundType() != restored.RoundType() {
+				t.Fatalf("expected round type to be %d, but it's actually %d", game.RoundType(), restored.RoundType())
+			}
+			if game.RoundType() != round.Regular {
+				// Non trivial rounds (robber, discard) are out of scope of this test
+				break
+			}
+			game.EndRound(playerID)
+			restored.EndRound(playerID)
+		}
+		if string(snapshotJSON(t, game)) != string(snapshotJSON(t, restored)) {
+			t.Errorf("expected both games to end up in the same state")
+		}
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		decoded.Version = SnapshotVersion + 1
+		_, err := RestoreSnapshot(&decoded)
+		if err == nil {
+			t.Errorf("expected to have error since snapshot version is unsupported, but actually no error was found")
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func TestSpecialBuildPhaseRotation(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+		MockWithSpecialBuildPhase(),
+	)
+
+	err := game.EndRound("1")
+	if err != nil {
+		t.Fatalf("expected to end round just fine, but actually got error %s", err.Error())
+	}
+	if game.RoundType() != round.SpecialBuild {
+		t.Errorf("expected round type to be SpecialBuild after ending round, actually got %s", game.round.GetCurrentRoundTypeDescription())
+	}
+
+	t.Run("only the current builder can end their window", func(t *testing.T) {
+		err := game.EndRound("1")
+		if err == nil {
+			t.Errorf("expected to have error since player 1 already had their turn, but actually no error was found")
+		}
+	})
+
+	t.Run("every other player builds in order", func(t *testing.T) {
+		for _, playerID := range []string{"2", "3", "4"} {
+			if game.CurrentRoundPlayer().ID != playerID {
+				t.Fatalf("expected player %s to be building, actually got player %s", playerID, game.CurrentRoundPlayer().ID)
+			}
+			if game.RoundType() != round.SpecialBuild {
+				t.Fatalf("expected round type to be SpecialBuild, actually got %s", game.round.GetCurrentRoundTypeDescription())
+			}
+			err := game.EndRound(playerID)
+			if err != nil {
+				t.Fatalf("expected player %s to end special build just fine, but actually got error %s", playerID, err.Error())
+			}
+		}
+	})
+
+	t.Run("next player rolls afterwards", func(t *testing.T) {
+		if game.RoundType() != round.BetweenTurns {
+			t.Errorf("expected round type to be BetweenTurns, actually got %s", game.round.GetCurrentRoundTypeDescription())
+		}
+		if game.CurrentRoundPlayer().ID != "2" {
+			t.Errorf("expected player 2 to roll next, actually got player %s", game.CurrentRoundPlayer().ID)
+		}
+	})
+}
+
+func TestSpecialBuildPhaseDisabled(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+	)
+
+	game.EndRound("1")
+	if game.RoundType() != round.BetweenTurns {
+		t.Errorf("expected round type to be BetweenTurns without special building phase, actually got %s", game.round.GetCurrentRoundTypeDescription())
+	}
+	if game.CurrentRoundPlayer().ID != "2" {
+		t.Errorf("expected player 2 to roll next, actually got player %s", game.CurrentRoundPlayer().ID)
+	}
+}
+
+func TestSpecialBuildPhaseActions(t *testing.T) {
+	createGame := func() *GameState {
+		return CreateTestGame(
+			MockWithRoundType(round.SpecialBuild),
+			MockWithCurrentRoundPlayer("1"),
+			MockWithRoadsByPlayer(map[string][]int{
+				"1": {54},
+			}),
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
+				"1": {
+					Lumber: 4,
+					Brick:  3,
+					Sheep:  2,
+					Grain:  1,
+					Ore:    1,
+				},
+			}),
+		)
+	}
+
+	t.Run("building a road is allowed", func(t *testing.T) {
+		game := createGame()
+		err := game.BuildRoad("1", 65)
+		if err != nil {
+			t.Errorf("expected to be able to build road in edge#65 during special build, but found error %s", err.Error())
+		}
+	})
+
+	t.Run("buying a development card is allowed", func(t *testing.T) {
+		game := createGame()
+		err := game.BuyDevelopmentCard("1")
+		if err != nil {
+			t.Errorf("expected to be able to buy development card during special build, but found error %s", err.Error())
+		}
+	})
+
+	t.Run("trading with the bank is not allowed", func(t *testing.T) {
+		game := createGame()
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1})
+		if err == nil {
+			t.Errorf("expected to have error since trading isn't allowed during special build, but actually no error was found")
+		}
+	})
+
+	t.Run("offering a trade is not allowed", func(t *testing.T) {
+		game := createGame()
+		_, err := game.MakeTradeOffer("1", coreT.Resources{Lumber: 1}, coreT.Resources{Ore: 1}, []string{})
+		if err == nil {
+			t.Errorf("expected to have error since trading isn't allowed during special build, but actually no error was found")
+		}
+	})
+
+	t.Run("rolling dice is not allowed", func(t *testing.T) {
+		game := createGame()
+		err := game.RollDice("1")
+		if err == nil {
+			t.Errorf("expected to have error since rolling dice isn't allowed during special build, but actually no error was found")
+		}
+	})
+}
+
+func TestBase6Game(t *testing.T) {
+	game := createSeededTestGame(t, 42, onMap("base6", 6), withParams(func(params *Params) { params.SpecialBuildPhase = 1 }))
+
+	if len(game.GetBoard()) != 30 {
+		t.Errorf("expected base6 to have 30 tiles, actually got %d", len(game.GetBoard()))
+	}
+	if !game.GetSettings().SpecialBuildPhase {
+		t.Errorf("expected special building phase to be enabled")
+	}
+	deserts := 0
+	for _, tile := range game.GetBoard() {
+		if tile.Resource == "Desert" {
+			deserts++
+		}
+	}
+	if deserts != 2 {
+		t.Errorf("expected base6 to have 2 deserts, actually got %d", deserts)
+	}
+}
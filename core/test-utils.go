@@ -16,7 +16,7 @@ type GameStateMock struct {
 	RoundType           int
 	RoundNumber         int
 	CurrentPlayerID     string
-	ResourcesByPlayer   map[string]map[string]int
+	ResourcesByPlayer   map[string]coreT.Resources
 	SettlementsByPlayer map[string][]int
 	RoadsByPlayer       map[string][]int
 	CitiesByPlayer      map[string][]int
@@ -46,6 +46,7 @@ func CreateTestGame(opts ...GameStateOption) *GameState {
 		MaxSettlements:       5,
 		MaxCities:            4,
 		MaxRoads:             20,
+		MaxShips:             15,
 		MaxDevCardsPerRound:  1,
 		TargetPoint:          10,
 		PointsPerSettlement:  1,
@@ -83,6 +84,7 @@ func CreateTestGameWithRand(randGenerator *rand.Rand, opts ...GameStateOption) *
 		MaxSettlements:       5,
 		MaxCities:            4,
 		MaxRoads:             20,
+		MaxShips:             15,
 		MaxDevCardsPerRound:  1,
 		TargetPoint:          10,
 		PointsPerSettlement:  1,
@@ -110,6 +112,24 @@ func MockWithRoundType(roundType round.Type) GameStateOption {
 	}
 }
 
+func MockWithSpecialBuildPhase() GameStateOption {
+	return func(gs *GameState) {
+		gs.specialBuildPhase = true
+	}
+}
+
+func MockWithFriendlyRobber(maxPoints int) GameStateOption {
+	return func(gs *GameState) {
+		gs.friendlyRobber = maxPoints
+	}
+}
+
+func MockWithRobberProtectionRounds(rounds int) GameStateOption {
+	return func(gs *GameState) {
+		gs.robberProtectionRounds = rounds
+	}
+}
+
 func MockWithRoundNumber(roundNumber int) GameStateOption {
 	return func(gs *GameState) {
 		gs.round.SetRoundNumber(roundNumber)
@@ -127,11 +147,12 @@ func MockWithCurrentRoundPlayer(playerID string) GameStateOption {
 	}
 }
 
-func MockWithResourcesByPlayer(resourcesByPlayer map[string]map[string]int) GameStateOption {
+func MockWithResourcesByPlayer(resourcesByPlayer map[string]coreT.Resources) GameStateOption {
 	return func(gs *GameState) {
 		for _, player := range gs.players {
 			playerState := gs.playersStates[player.ID]
 			playerState.SetResources(resourcesByPlayer[player.ID])
+			gs.bank.Take(resourcesByPlayer[player.ID])
 		}
 	}
 }
@@ -206,6 +227,19 @@ func MockWithRoadsByPlayer(roadsByPlayer map[string][]int) GameStateOption {
 	}
 }
 
+func MockWithShipsByPlayer(shipsByPlayer map[string][]int) GameStateOption {
+	return func(gs *GameState) {
+		for _, player := range gs.players {
+			playerState := gs.playersStates[player.ID]
+			for _, edgeID := range shipsByPlayer[player.ID] {
+				playerState.AddShip(edgeID)
+				gs.board.AddShip(player.ID, edgeID)
+			}
+			gs.computeLongestRoad(player.ID)
+		}
+	}
+}
+
 func MockWithBlockedTile(tileID int) GameStateOption {
 	return func(gs *GameState) {
 		for i, tile := range gs.board.GetTiles() {
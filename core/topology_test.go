@@ -0,0 +1,76 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	coreMaps "github.com/victoroliveirab/settlers/core/maps"
+)
+
+func TestBuildTopology(t *testing.T) {
+	t.Run("topology - neighbouring tiles share vertices and edge", func(t *testing.T) {
+		definitions := coreMaps.MapDefinition{
+			Tiles: []int{1, 2},
+			HexCoordinatesByTile: map[int][3]int{
+				1: {0, 0, 0},
+				2: {1, 0, -1},
+			},
+		}
+		err := coreMaps.BuildTopology(&definitions)
+		if err != nil {
+			t.Fatalf("expected to build topology just fine, but actually got error %s", err.Error())
+		}
+
+		expectedVertices := map[int][6]int{
+			1: {1, 2, 3, 4, 5, 6},
+			2: {7, 8, 9, 10, 3, 2},
+		}
+		if !reflect.DeepEqual(definitions.VerticesByTile, expectedVertices) {
+			t.Errorf("expected vertices %v, actually got %v", expectedVertices, definitions.VerticesByTile)
+		}
+		expectedEdges := map[int][6]int{
+			1: {1, 2, 3, 4, 5, 6},
+			2: {7, 8, 9, 10, 2, 11},
+		}
+		if !reflect.DeepEqual(definitions.EdgesByTile, expectedEdges) {
+			t.Errorf("expected edges %v, actually got %v", expectedEdges, definitions.EdgesByTile)
+		}
+		if !reflect.DeepEqual(definitions.TilesByVertex[2], []int{0, 1}) {
+			t.Errorf("expected vertex#2 to be in both tiles, actually got %v", definitions.TilesByVertex[2])
+		}
+		if definitions.VerticesByEdge[2] != [2]int{2, 3} {
+			t.Errorf("expected edge#2 to go from vertex#2 to vertex#3, actually got %v", definitions.VerticesByEdge[2])
+		}
+		if !reflect.DeepEqual(definitions.EdgesByVertex[3], []int{2, 3, 10}) {
+			t.Errorf("expected vertex#3 to have edges [2 3 10], actually got %v", definitions.EdgesByVertex[3])
+		}
+	})
+
+	t.Run("topology - base4 derived from coordinates", func(t *testing.T) {
+		coreMaps.LoadMap("base4")
+		definitions, _ := coreMaps.GetMapDefinitions("base4")
+		if len(definitions.TilesByVertex) != 54 {
+			t.Errorf("expected 54 vertices, actually got %d", len(definitions.TilesByVertex))
+		}
+		if len(definitions.VerticesByEdge) != 72 {
+			t.Errorf("expected 72 edges, actually got %d", len(definitions.VerticesByEdge))
+		}
+		if definitions.VerticesByTile[10] != [6]int{31, 32, 33, 21, 20, 19} {
+			t.Errorf("expected center tile vertices to keep their numbering, actually got %v", definitions.VerticesByTile[10])
+		}
+	})
+
+	t.Run("topology - duplicated coordinates", func(t *testing.T) {
+		definitions := coreMaps.MapDefinition{
+			Tiles: []int{1, 2},
+			HexCoordinatesByTile: map[int][3]int{
+				1: {0, 0, 0},
+				2: {0, 0, 0},
+			},
+		}
+		err := coreMaps.BuildTopology(&definitions)
+		if err == nil {
+			t.Errorf("expected to have error since tiles share coordinates, but actually no error was found")
+		}
+	})
+}
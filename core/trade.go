@@ -5,10 +5,13 @@ import (
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	"github.com/victoroliveirab/settlers/core/packages/trade"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
-func (state *GameState) MakeBankTrade(playerID string, givenResources, requestedResources map[string]int) error {
+func (state *GameState) MakeBankTrade(playerID string, givenResources, requestedResources coreT.Resources) (err error) {
+	defer state.record(Command{Type: CommandMakeBankTrade, PlayerID: playerID, Given: givenResources, Requested: requestedResources})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot trade with bank during other player's turn")
 		return err
@@ -35,7 +38,9 @@ func (state *GameState) MakeBankTrade(playerID string, givenResources, requested
 	)
 }
 
-func (state *GameState) MakeGeneralPortTrade(playerID string, givenResources, requestedResources map[string]int) error {
+func (state *GameState) MakeGeneralPortTrade(playerID string, givenResources, requestedResources coreT.Resources) (err error) {
+	defer state.record(Command{Type: CommandMakeGeneralPortTrade, PlayerID: playerID, Given: givenResources, Requested: requestedResources})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot trade with port during other player's turn")
 		return err
@@ -55,7 +60,9 @@ func (state *GameState) MakeGeneralPortTrade(playerID string, givenResources, re
 	)
 }
 
-func (state *GameState) MakeResourcePortTrade(playerID string, givenResources, requestedResources map[string]int) error {
+func (state *GameState) MakeResourcePortTrade(playerID string, givenResources, requestedResources coreT.Resources) (err error) {
+	defer state.record(Command{Type: CommandMakeResourcePortTrade, PlayerID: playerID, Given: givenResources, Requested: requestedResources})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot trade with port during other player's turn")
 		return err
@@ -75,7 +82,9 @@ func (state *GameState) MakeResourcePortTrade(playerID string, givenResources, r
 	)
 }
 
-func (state *GameState) MakeTradeOffer(playerID string, givenResources, requestedResources map[string]int, blockedPlayers []string) (int, error) {
+func (state *GameState) MakeTradeOffer(playerID string, givenResources, requestedResources coreT.Resources, blockedPlayers []string) (newTradeID int, err error) {
+	defer state.record(Command{Type: CommandMakeTradeOffer, PlayerID: playerID, Given: givenResources, Requested: requestedResources, BlockedPlayers: blockedPlayers})(&err)
+
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot create trade offer during other player's turn")
 		return -1, err
@@ -96,7 +105,9 @@ func (state *GameState) MakeTradeOffer(playerID string, givenResources, requeste
 	)
 }
 
-func (state *GameState) MakeCounterTradeOffer(playerID string, tradeID int, givenResources, requestedResources map[string]int) (int, error) {
+func (state *GameState) MakeCounterTradeOffer(playerID string, tradeID int, givenResources, requestedResources coreT.Resources) (newTradeID int, err error) {
+	defer state.record(Command{Type: CommandMakeCounterTradeOffer, PlayerID: playerID, TradeID: tradeID, Given: givenResources, Requested: requestedResources})(&err)
+
 	playerState := state.playersStates[playerID]
 	return state.trade.MakeCounterTradeOffer(
 		playerState,
@@ -107,12 +118,16 @@ func (state *GameState) MakeCounterTradeOffer(playerID string, tradeID int, give
 	)
 }
 
-func (state *GameState) AcceptTradeOffer(playerID string, tradeID int) error {
+func (state *GameState) AcceptTradeOffer(playerID string, tradeID int) (err error) {
+	defer state.record(Command{Type: CommandAcceptTradeOffer, PlayerID: playerID, TradeID: tradeID})(&err)
+
 	playerState := state.playersStates[playerID]
 	return state.trade.AcceptTradeOffer(playerState, tradeID)
 }
 
-func (state *GameState) FinalizeTrade(playerID, accepterID string, tradeID int) error {
+func (state *GameState) FinalizeTrade(playerID, accepterID string, tradeID int) (err error) {
+	defer state.record(Command{Type: CommandFinalizeTrade, PlayerID: playerID, TargetPlayerID: accepterID, TradeID: tradeID})(&err)
+
 	// REFACTOR: Probably unnecessary? -> will be cought below
 	if playerID != state.currentPlayer().ID {
 		err := fmt.Errorf("Cannot finalize a trade during other player's round")
@@ -120,15 +135,32 @@ func (state *GameState) FinalizeTrade(playerID, accepterID string, tradeID int)
 	}
 	ownerState := state.playersStates[playerID]
 	accepterState := state.playersStates[accepterID]
-	return state.trade.FinalizeTrade(ownerState, accepterState, tradeID)
+	err = state.trade.FinalizeTrade(ownerState, accepterState, tradeID)
+	if err != nil {
+		return err
+	}
+
+	trade := state.trade.GetTrade(tradeID)
+	state.emit(TradeFinalized{
+		TradeID:     tradeID,
+		RequesterID: playerID,
+		AccepterID:  accepterID,
+		Offer:       trade.Offer,
+		Request:     trade.Request,
+	})
+	return nil
 }
 
-func (state *GameState) RejectTradeOffer(playerID string, tradeID int) error {
+func (state *GameState) RejectTradeOffer(playerID string, tradeID int) (err error) {
+	defer state.record(Command{Type: CommandRejectTradeOffer, PlayerID: playerID, TradeID: tradeID})(&err)
+
 	playerState := state.playersStates[playerID]
 	return state.trade.RejectTradeOffer(playerState, tradeID)
 }
 
-func (state *GameState) CancelTradeOffer(playerID string, tradeID int) error {
+func (state *GameState) CancelTradeOffer(playerID string, tradeID int) (err error) {
+	defer state.record(Command{Type: CommandCancelTradeOffer, PlayerID: playerID, TradeID: tradeID})(&err)
+
 	playerState := state.playersStates[playerID]
 	return state.trade.CancelTradeOffer(playerState, tradeID)
 }
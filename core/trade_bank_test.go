@@ -5,34 +5,35 @@ import (
 	"testing"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestTradeWithBankWithAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("trade with bank - player has available resources", func(t *testing.T) {
-		err := game.MakeBankTrade("1", map[string]int{"Lumber": 4}, map[string]int{"Ore": 1})
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1})
 		if err != nil {
 			t.Errorf("expected to trade with bank just fine, but actually got error %s", err.Error())
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -40,29 +41,29 @@ func TestTradeWithBankWithAvailableResources(t *testing.T) {
 func TestTradeWithBankWithNoAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 3,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 3,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("trade with bank - player doesn't have available resources", func(t *testing.T) {
-		err := game.MakeBankTrade("1", map[string]int{"Lumber": 4}, map[string]int{"Ore": 1})
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1})
 		if err == nil {
 			t.Errorf("expected to not be able to trade with bank, but actually traded just fine")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 3 {
-			t.Errorf("expected player#1 to have 3 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 3 {
+			t.Errorf("expected player#1 to have 3 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -71,13 +72,13 @@ func TestTradeWithBankByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 4,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 4,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 			}),
 		)
@@ -106,7 +107,7 @@ func TestTradeWithBankByRound(t *testing.T) {
 		testname := fmt.Sprintf("round type: %d, will have error: %v", roundType, willHaveError)
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
-			err := game.MakeBankTrade("1", map[string]int{"Lumber": 4}, map[string]int{"Ore": 1})
+			err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1})
 			hasErr := err != nil
 			if hasErr != willHaveError {
 				t.Errorf("expected error to be %v, but actually was %v", willHaveError, hasErr)
@@ -118,21 +119,42 @@ func TestTradeWithBankByRound(t *testing.T) {
 func TestTradeWithBankNotPlayerRound(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("trade with bank - not player's round", func(t *testing.T) {
-		err := game.MakeBankTrade("1", map[string]int{"Lumber": 4}, map[string]int{"Ore": 1})
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1})
 		if err == nil {
 			t.Errorf("expected to not be able to trade with bank during other player's round, but traded just fine")
 		}
 	})
 }
+
+func TestTradeWithBankWithNegativeQuantities(t *testing.T) {
+	game := CreateTestGame(
+		MockWithRoundType(round.Regular),
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
+			"1": {
+				Lumber: 4,
+			},
+		}),
+	)
+
+	t.Run("trade with bank - negative quantity requested", func(t *testing.T) {
+		err := game.MakeBankTrade("1", coreT.Resources{Lumber: 4}, coreT.Resources{Ore: 1, Grain: -1})
+		if err == nil {
+			t.Errorf("expected to not be able to trade a negative quantity, but actually traded just fine")
+		}
+		if game.ResourceHandByPlayer("1").Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber, actually got %d", game.ResourceHandByPlayer("1").Lumber)
+		}
+	})
+}
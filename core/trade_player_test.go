@@ -6,35 +6,36 @@ import (
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	"github.com/victoroliveirab/settlers/core/packages/trade"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestCreateTradeOfferWithAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a trade offer - player has available resources", func(t *testing.T) {
-		_, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		_, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -64,30 +65,30 @@ func TestCreateTradeOfferWithAvailableResources(t *testing.T) {
 func TestCreateTradeOfferWithNoAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  0,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  0,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a trade offer - player hasn't all available resources", func(t *testing.T) {
-		_, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		_, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err == nil {
 			t.Errorf("expected not to be able to make trade offer, but actually no error was found")
@@ -104,13 +105,13 @@ func TestCreateTradeOfferByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 			}),
 		)
@@ -140,11 +141,11 @@ func TestCreateTradeOfferByRound(t *testing.T) {
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
 			_, err := game.MakeTradeOffer("1",
-				map[string]int{
-					"Lumber": 1,
+				coreT.Resources{
+					Lumber: 1,
 				},
-				map[string]int{
-					"Ore": 1,
+				coreT.Resources{
+					Ore: 1,
 				},
 				[]string{})
 			hasErr := err != nil
@@ -158,22 +159,22 @@ func TestCreateTradeOfferByRound(t *testing.T) {
 func TestCreateTradeOfferNotPlayerRound(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create trade offer - not player's round", func(t *testing.T) {
-		_, err := game.MakeTradeOffer("2", map[string]int{
-			"Lumber": 1,
-		}, map[string]int{
-			"Brick": 1,
+		_, err := game.MakeTradeOffer("2", coreT.Resources{
+			Lumber: 1,
+		}, coreT.Resources{
+			Brick: 1,
 		}, []string{})
 		if err == nil {
 			t.Errorf("expected to not be able to create trade offer during other player's round, but traded just fine")
@@ -184,22 +185,22 @@ func TestCreateTradeOfferNotPlayerRound(t *testing.T) {
 func TestCreateTradeOfferWithBlockedPlayers(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create trade offer - has blocked player", func(t *testing.T) {
-		_, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-		}, map[string]int{
-			"Brick": 1,
+		_, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+		}, coreT.Resources{
+			Brick: 1,
 		}, []string{"2"})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -215,40 +216,40 @@ func TestCreateTradeOfferWithBlockedPlayers(t *testing.T) {
 func TestCreateCounterTradeOfferWithAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - player has available resources", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 2,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		_, err = game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 2,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -274,51 +275,51 @@ func TestCreateCounterTradeOfferWithAvailableResources(t *testing.T) {
 func TestCreateCounterTradeOfferOfCounterTradeOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - parent trade offer is a counter trade offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 2,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 2,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("1", counterTradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
-		}, map[string]int{
-			"Ore": 1,
+		_, err = game.MakeCounterTradeOffer("1", counterTradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter offer of counter offer just fine, but actually got error %s", err.Error())
@@ -334,40 +335,40 @@ func TestCreateCounterTradeOfferOfCounterTradeOffer(t *testing.T) {
 func TestCreateCounterTradeOfferWithNoAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - player hasn't enough available resources", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Ore": 2,
-		}, map[string]int{
-			"Lumber": 2,
-			"Brick":  1,
+		_, err = game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Ore: 2,
+		}, coreT.Resources{
+			Lumber: 2,
+			Brick:  1,
 		})
 		if err == nil {
 			t.Errorf("expected not to be able to make counter trade offer, but actually no error was found")
@@ -388,30 +389,30 @@ func TestCreateCounterTradeOfferWithNoAvailableResources(t *testing.T) {
 func TestCreateCounterTradeOfferNonExistentTradeOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - trade offer doesn't exist", func(t *testing.T) {
-		_, err := game.MakeCounterTradeOffer("2", 0, map[string]int{
-			"Ore": 2,
-		}, map[string]int{
-			"Lumber": 2,
-			"Brick":  1,
+		_, err := game.MakeCounterTradeOffer("2", 0, coreT.Resources{
+			Ore: 2,
+		}, coreT.Resources{
+			Lumber: 2,
+			Brick:  1,
 		})
 		if err == nil {
 			t.Errorf("expected not to be able to make counter trade offer, but actually no error was found")
@@ -422,20 +423,20 @@ func TestCreateCounterTradeOfferNonExistentTradeOffer(t *testing.T) {
 // func TestCreateCounterTradeOfferParentTradeOfferAlreadyFinalized(t *testing.T) {
 // 	game := CreateTestGame(
 // 		MockWithRoundType(Regular),
-// 		MockWithResourcesByPlayer(map[string]map[string]int{
+// 		MockWithResourcesByPlayer(map[string]coreT.Resources{
 // 			"1": {
-// 				"Lumber": 1,
-// 				"Brick":  1,
-// 				"Sheep":  1,
-// 				"Grain":  1,
-// 				"Ore":    1,
+// 				Lumber: 1,
+// 				Brick:  1,
+// 				Sheep:  1,
+// 				Grain:  1,
+// 				Ore:    1,
 // 			},
 // 			"2": {
-// 				"Lumber": 1,
-// 				"Brick":  1,
-// 				"Sheep":  1,
-// 				"Grain":  1,
-// 				"Ore":    1,
+// 				Lumber: 1,
+// 				Brick:  1,
+// 				Sheep:  1,
+// 				Grain:  1,
+// 				Ore:    1,
 // 			},
 // 		}),
 // 	)
@@ -448,39 +449,39 @@ func TestCreateCounterTradeOfferNonExistentTradeOffer(t *testing.T) {
 func TestCreateCounterTradeOfferAsBlockedPlayer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - player is blocked from parent trade offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{"2"})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Ore": 1,
-		}, map[string]int{
-			"Lumber": 1,
+		_, err = game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Ore: 1,
+		}, coreT.Resources{
+			Lumber: 1,
 		})
 		if err == nil {
 			t.Errorf("expected not to be able to make counter trade offer as blocked player, but actually no error was found")
@@ -491,40 +492,40 @@ func TestCreateCounterTradeOfferAsBlockedPlayer(t *testing.T) {
 func TestCreateCounterTradeOfferEqualToParentTrade(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - offer is equal to original trade", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		_, err = game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err == nil {
 			t.Errorf("expected not to be able to make counter trade offer equal to parent trade, but actually no error was found")
@@ -536,16 +537,16 @@ func TestCreateCounterTradeOfferByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 				"2": {
-					"Ore": 1,
+					Ore: 1,
 				},
 			}),
 		)
@@ -575,18 +576,18 @@ func TestCreateCounterTradeOfferByRound(t *testing.T) {
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
 			tradeID, _ := game.MakeTradeOffer("1",
-				map[string]int{
-					"Lumber": 1,
+				coreT.Resources{
+					Lumber: 1,
 				},
-				map[string]int{
-					"Ore": 1,
+				coreT.Resources{
+					Ore: 1,
 				},
 				[]string{})
 			_, err := game.MakeCounterTradeOffer("2", tradeID,
-				map[string]int{
-					"Lumber": 2,
-				}, map[string]int{
-					"Ore": 1,
+				coreT.Resources{
+					Lumber: 2,
+				}, coreT.Resources{
+					Ore: 1,
 				},
 			)
 			hasErr := err != nil
@@ -601,40 +602,40 @@ func TestCreateCounterTradeOfferByRound(t *testing.T) {
 func TestCreateCounterTradeOfferOwnTrade(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("create a counter trade offer - counter offer to own offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("1", tradeID, map[string]int{
-			"Ore": 1,
-		}, map[string]int{
-			"Lumber": 2,
-			"Brick":  1,
+		_, err = game.MakeCounterTradeOffer("1", tradeID, coreT.Resources{
+			Ore: 1,
+		}, coreT.Resources{
+			Lumber: 2,
+			Brick:  1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to create counter trade offer to own trade offer, but it actually made just fine")
@@ -645,30 +646,30 @@ func TestCreateCounterTradeOfferOwnTrade(t *testing.T) {
 func TestAcceptTradeOfferWithAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a trade offer - players have the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -695,48 +696,48 @@ func TestAcceptTradeOfferWithAvailableResources(t *testing.T) {
 func TestAcceptTradeOfferIsCounterTradeOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"3": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a counter trade offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
-		}, map[string]int{
-			"Ore": 1,
+		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -752,30 +753,30 @@ func TestAcceptTradeOfferIsCounterTradeOffer(t *testing.T) {
 func TestAcceptTradeOfferWithNoAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a trade offer - player has not the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 2,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 2,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -791,30 +792,30 @@ func TestAcceptTradeOfferWithNoAvailableResources(t *testing.T) {
 func TestAcceptTradeOfferAsBlockedPlayer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a trade offer - player is blocked", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{"2"})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -830,48 +831,48 @@ func TestAcceptTradeOfferAsBlockedPlayer(t *testing.T) {
 func TestAcceptCounterTradeOfferAsBlockedPlayerOfOriginalTrade(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"3": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a counter trade offer - player is blocked from parent trade", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{"2"})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		counterTradeID, err := game.MakeCounterTradeOffer("3", tradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
-		}, map[string]int{
-			"Ore": 1,
+		counterTradeID, err := game.MakeCounterTradeOffer("3", tradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -888,16 +889,16 @@ func TestAcceptTradeOfferByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 				"2": {
-					"Ore": 1,
+					Ore: 1,
 				},
 			}),
 		)
@@ -927,11 +928,11 @@ func TestAcceptTradeOfferByRound(t *testing.T) {
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
 			tradeID, _ := game.MakeTradeOffer("1",
-				map[string]int{
-					"Lumber": 1,
+				coreT.Resources{
+					Lumber: 1,
 				},
-				map[string]int{
-					"Ore": 1,
+				coreT.Resources{
+					Ore: 1,
 				},
 				[]string{})
 			err := game.AcceptTradeOffer("2", tradeID)
@@ -946,30 +947,30 @@ func TestAcceptTradeOfferByRound(t *testing.T) {
 func TestAcceptTradeOfferOwnOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a trade offer - accept own offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -985,41 +986,41 @@ func TestAcceptTradeOfferOwnOffer(t *testing.T) {
 func TestAcceptTradeOfferOriginalCreatorTriesToAcceptCounterOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("accept a counter trade offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
-		}, map[string]int{
-			"Ore": 1,
+		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -1035,20 +1036,20 @@ func TestAcceptTradeOfferOriginalCreatorTriesToAcceptCounterOffer(t *testing.T)
 func TestAcceptTradeOfferNonExistentTradeOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
@@ -1066,30 +1067,30 @@ func TestAcceptTradeOfferNonExistentTradeOffer(t *testing.T) {
 func TestRejectTradeOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("reject a trade offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -1116,30 +1117,30 @@ func TestRejectTradeOffer(t *testing.T) {
 func TestRejectTradeOfferOwnCreator(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("reject a trade offer", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -1155,48 +1156,48 @@ func TestRejectTradeOfferOwnCreator(t *testing.T) {
 func TestRejectTradeOfferOriginalCreatorRejectsCounterOffer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"3": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("reject a counter trade offer as creator of original", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
-		}, map[string]int{
-			"Ore": 1,
+		counterTradeID, err := game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -1227,30 +1228,30 @@ func TestRejectTradeOfferOriginalCreatorRejectsCounterOffer(t *testing.T) {
 func TestRejectTradeOfferAsBlockedPlayer(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("reject a counter trade offer as creator of original", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{"2"})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -1266,30 +1267,30 @@ func TestRejectTradeOfferAsBlockedPlayer(t *testing.T) {
 func TestFinalizeAcceptedTradeOfferWithAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("finalize a regular trade offer - players have the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
@@ -1312,23 +1313,23 @@ func TestFinalizeAcceptedTradeOfferWithAvailableResources(t *testing.T) {
 
 		player1Resources := game.ResourceHandByPlayer("1")
 		player2Resources := game.ResourceHandByPlayer("2")
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 0 {
-			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 0 {
+			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources.Ore)
 		}
-		if player2Resources["Lumber"] != 2 {
-			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources["Lumber"])
+		if player2Resources.Lumber != 2 {
+			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources.Lumber)
 		}
-		if player2Resources["Brick"] != 2 {
-			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources["Brick"])
+		if player2Resources.Brick != 2 {
+			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources.Brick)
 		}
-		if player2Resources["Ore"] != 0 {
-			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources["Ore"])
+		if player2Resources.Ore != 0 {
+			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources.Ore)
 		}
 
 		activeTrades := game.ActiveTradeOffers()
@@ -1341,38 +1342,38 @@ func TestFinalizeAcceptedTradeOfferWithAvailableResources(t *testing.T) {
 func TestFinalizeAcceptedTradeOfferWithAccepterNoLongerHavingAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("finalize a regular trade offer - players has not the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
-		secondTradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Grain": 1,
-		}, map[string]int{
-			"Ore": 1,
+		secondTradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Grain: 1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 
 		err = game.AcceptTradeOffer("2", tradeID)
@@ -1395,23 +1396,23 @@ func TestFinalizeAcceptedTradeOfferWithAccepterNoLongerHavingAvailableResources(
 
 		player1Resources := game.ResourceHandByPlayer("1")
 		player2Resources := game.ResourceHandByPlayer("2")
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 0 {
-			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 0 {
+			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources.Ore)
 		}
-		if player2Resources["Lumber"] != 2 {
-			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources["Lumber"])
+		if player2Resources.Lumber != 2 {
+			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources.Lumber)
 		}
-		if player2Resources["Brick"] != 2 {
-			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources["Brick"])
+		if player2Resources.Brick != 2 {
+			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources.Brick)
 		}
-		if player2Resources["Ore"] != 0 {
-			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources["Ore"])
+		if player2Resources.Ore != 0 {
+			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources.Ore)
 		}
 
 		activeTrades := game.ActiveTradeOffers()
@@ -1429,39 +1430,39 @@ func TestFinalizeAcceptedTradeOfferWithAccepterNoLongerHavingAvailableResources(
 func TestFinalizeAcceptedTradeOfferWithRequesterNoLongerHavingAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("finalize a regular trade offer - players has not the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
-		secondTradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		secondTradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 
 		err = game.AcceptTradeOffer("2", tradeID)
@@ -1484,23 +1485,23 @@ func TestFinalizeAcceptedTradeOfferWithRequesterNoLongerHavingAvailableResources
 
 		player1Resources := game.ResourceHandByPlayer("1")
 		player2Resources := game.ResourceHandByPlayer("2")
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 0 {
-			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 0 {
+			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources.Ore)
 		}
-		if player2Resources["Lumber"] != 2 {
-			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources["Lumber"])
+		if player2Resources.Lumber != 2 {
+			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources.Lumber)
 		}
-		if player2Resources["Brick"] != 2 {
-			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources["Brick"])
+		if player2Resources.Brick != 2 {
+			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources.Brick)
 		}
-		if player2Resources["Ore"] != 0 {
-			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources["Ore"])
+		if player2Resources.Ore != 0 {
+			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources.Ore)
 		}
 
 		activeTrades := game.ActiveTradeOffers()
@@ -1514,16 +1515,16 @@ func TestFinalizeAcceptedTradeOfferByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 1,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 1,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 				"2": {
-					"Ore": 1,
+					Ore: 1,
 				},
 			}),
 		)
@@ -1553,11 +1554,11 @@ func TestFinalizeAcceptedTradeOfferByRound(t *testing.T) {
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
 			tradeID, _ := game.MakeTradeOffer("1",
-				map[string]int{
-					"Lumber": 1,
+				coreT.Resources{
+					Lumber: 1,
 				},
-				map[string]int{
-					"Ore": 1,
+				coreT.Resources{
+					Ore: 1,
 				},
 				[]string{})
 			game.AcceptTradeOffer("2", tradeID)
@@ -1573,41 +1574,41 @@ func TestFinalizeAcceptedTradeOfferByRound(t *testing.T) {
 func TestFinalizeCounterOfferWithAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("finalize a counter trade offer - players have the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		counterOfferID, err := game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
-		}, map[string]int{
-			"Ore": 1,
+		counterOfferID, err := game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -1625,29 +1626,29 @@ func TestFinalizeCounterOfferWithAvailableResources(t *testing.T) {
 
 		player1Resources := game.ResourceHandByPlayer("1")
 		player2Resources := game.ResourceHandByPlayer("2")
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 0 {
-			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 0 {
+			t.Errorf("expected player#1 to have 0 Brick, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 0 {
-			t.Errorf("expected player#1 to have 0 Sheep, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 0 {
+			t.Errorf("expected player#1 to have 0 Sheep, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore, actually got %d", player1Resources.Ore)
 		}
-		if player2Resources["Lumber"] != 2 {
-			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources["Lumber"])
+		if player2Resources.Lumber != 2 {
+			t.Errorf("expected player#2 to have 2 Lumber, actually got %d", player2Resources.Lumber)
 		}
-		if player2Resources["Brick"] != 2 {
-			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources["Brick"])
+		if player2Resources.Brick != 2 {
+			t.Errorf("expected player#2 to have 2 Brick, actually got %d", player2Resources.Brick)
 		}
-		if player2Resources["Sheep"] != 2 {
-			t.Errorf("expected player#2 to have 2 Sheep, actually got %d", player2Resources["Sheep"])
+		if player2Resources.Sheep != 2 {
+			t.Errorf("expected player#2 to have 2 Sheep, actually got %d", player2Resources.Sheep)
 		}
-		if player2Resources["Ore"] != 0 {
-			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources["Ore"])
+		if player2Resources.Ore != 0 {
+			t.Errorf("expected player#2 to have 0 Ore, actually got %d", player2Resources.Ore)
 		}
 	})
 }
@@ -1655,48 +1656,48 @@ func TestFinalizeCounterOfferWithAvailableResources(t *testing.T) {
 func TestFinalizeTradeOfferWithActiveCounterOfferAndAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"2": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 			"3": {
-				"Lumber": 1,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 1,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 	)
 
 	t.Run("finalize a trade offer with an active counter offer - players have the resources available", func(t *testing.T) {
-		tradeID, err := game.MakeTradeOffer("1", map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-		}, map[string]int{
-			"Ore": 1,
+		tradeID, err := game.MakeTradeOffer("1", coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+		}, coreT.Resources{
+			Ore: 1,
 		}, []string{})
 		if err != nil {
 			t.Errorf("expected to make trade offer just fine, but actually got error %s", err.Error())
 		}
 
-		_, err = game.MakeCounterTradeOffer("2", tradeID, map[string]int{
-			"Ore": 1,
-		}, map[string]int{
-			"Lumber": 1,
-			"Brick":  1,
-			"Sheep":  1,
+		_, err = game.MakeCounterTradeOffer("2", tradeID, coreT.Resources{
+			Ore: 1,
+		}, coreT.Resources{
+			Lumber: 1,
+			Brick:  1,
+			Sheep:  1,
 		})
 		if err != nil {
 			t.Errorf("expected to make counter trade offer just fine, but actually got error %s", err.Error())
@@ -5,18 +5,19 @@ import (
 	"testing"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
 func TestTradeWithGeneralPortSuccess(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  4,
-				"Sheep":  3,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  4,
+				Sheep:  3,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -25,32 +26,32 @@ func TestTradeWithGeneralPortSuccess(t *testing.T) {
 	)
 
 	t.Run("trade with general port - success", func(t *testing.T) {
-		err := game.MakeGeneralPortTrade("1", map[string]int{
-			"Brick": 3,
-			"Sheep": 3,
-		}, map[string]int{
-			"Grain": 1,
-			"Ore":   1,
+		err := game.MakeGeneralPortTrade("1", coreT.Resources{
+			Brick: 3,
+			Sheep: 3,
+		}, coreT.Resources{
+			Grain: 1,
+			Ore:   1,
 		})
 		if err != nil {
 			t.Errorf("expected to make port trades just fine, but actually got error %s", err.Error())
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 1 {
-			t.Errorf("expected player#1 to have 1 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 1 {
+			t.Errorf("expected player#1 to have 1 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 0 {
-			t.Errorf("expected player#1 to have 0 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 0 {
+			t.Errorf("expected player#1 to have 0 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 2 {
-			t.Errorf("expected player#1 to have 2 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 2 {
+			t.Errorf("expected player#1 to have 2 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -58,13 +59,13 @@ func TestTradeWithGeneralPortSuccess(t *testing.T) {
 func TestTradeWithGeneralPortNotAvailablePort(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -73,30 +74,30 @@ func TestTradeWithGeneralPortNotAvailablePort(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - doesn't own required port", func(t *testing.T) {
-		err := game.MakeGeneralPortTrade("1", map[string]int{
-			"Lumber": 3,
-		}, map[string]int{
-			"Sheep": 1,
+		err := game.MakeGeneralPortTrade("1", coreT.Resources{
+			Lumber: 3,
+		}, coreT.Resources{
+			Sheep: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -104,13 +105,13 @@ func TestTradeWithGeneralPortNotAvailablePort(t *testing.T) {
 func TestTradeWithGeneralPortResourcePortAvailable(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -119,32 +120,32 @@ func TestTradeWithGeneralPortResourcePortAvailable(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - owns specific port", func(t *testing.T) {
-		err := game.MakeGeneralPortTrade("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-		}, map[string]int{
-			"Sheep": 1,
-			"Ore":   1,
+		err := game.MakeGeneralPortTrade("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+		}, coreT.Resources{
+			Sheep: 1,
+			Ore:   1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -152,13 +153,13 @@ func TestTradeWithGeneralPortResourcePortAvailable(t *testing.T) {
 func TestTradeWithGeneralPortNotAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 2,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 2,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -167,32 +168,32 @@ func TestTradeWithGeneralPortNotAvailableResources(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - doesn't have required resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-		}, map[string]int{
-			"Sheep": 1,
-			"Grain": 1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+		}, coreT.Resources{
+			Sheep: 1,
+			Grain: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 2 {
-			t.Errorf("expected player#1 to have 2 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 2 {
+			t.Errorf("expected player#1 to have 2 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -201,13 +202,13 @@ func TestTradeWithGeneralPortByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 3,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 3,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 			}),
 			MockWithPortsByPlayer(map[string][]string{
@@ -239,7 +240,7 @@ func TestTradeWithGeneralPortByRound(t *testing.T) {
 		testname := fmt.Sprintf("round type: %d, will have error: %v", roundType, willHaveError)
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
-			err := game.MakeGeneralPortTrade("1", map[string]int{"Lumber": 3}, map[string]int{"Ore": 1})
+			err := game.MakeGeneralPortTrade("1", coreT.Resources{Lumber: 3}, coreT.Resources{Ore: 1})
 			hasErr := err != nil
 			if hasErr != willHaveError {
 				t.Errorf("expected error to be %v, but actually was %v", willHaveError, hasErr)
@@ -251,13 +252,13 @@ func TestTradeWithGeneralPortByRound(t *testing.T) {
 func TestTradeWithGeneralPortNotPlayerRound(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"2": {
-				"Lumber": 3,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 3,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -266,10 +267,10 @@ func TestTradeWithGeneralPortNotPlayerRound(t *testing.T) {
 	)
 
 	t.Run("trade with resource port - not player's round", func(t *testing.T) {
-		err := game.MakeGeneralPortTrade("2", map[string]int{
-			"Lumber": 3,
-		}, map[string]int{
-			"Ore": 1,
+		err := game.MakeGeneralPortTrade("2", coreT.Resources{
+			Lumber: 3,
+		}, coreT.Resources{
+			Ore: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to trade with port during other player's round, but traded just fine")
@@ -280,13 +281,13 @@ func TestTradeWithGeneralPortNotPlayerRound(t *testing.T) {
 func TestTradeWithGeneralPortIncorrectNumberOfGivenResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -295,32 +296,32 @@ func TestTradeWithGeneralPortIncorrectNumberOfGivenResources(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - incorrect number of given resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 4,
-			"Brick":  3,
-		}, map[string]int{
-			"Sheep": 2,
-			"Grain": 1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 4,
+			Brick:  3,
+		}, coreT.Resources{
+			Sheep: 2,
+			Grain: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -328,13 +329,13 @@ func TestTradeWithGeneralPortIncorrectNumberOfGivenResources(t *testing.T) {
 func TestTradeWithGeneralPortIncorrectNumberOfRequestedResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -343,31 +344,31 @@ func TestTradeWithGeneralPortIncorrectNumberOfRequestedResources(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - incorrect number of requested resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-		}, map[string]int{
-			"Sheep": 1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+		}, coreT.Resources{
+			Sheep: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -375,13 +376,13 @@ func TestTradeWithGeneralPortIncorrectNumberOfRequestedResources(t *testing.T) {
 func TestTradeWithGeneralPortResourcePresentBothInGivenAndRequested(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -390,32 +391,32 @@ func TestTradeWithGeneralPortResourcePresentBothInGivenAndRequested(t *testing.T
 	)
 
 	t.Run("trade with resource port fail - incorrect number of given resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  3,
-		}, map[string]int{
-			"Lumber": 1,
-			"Sheep":  1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  3,
+		}, coreT.Resources{
+			Lumber: 1,
+			Sheep:  1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -423,13 +424,13 @@ func TestTradeWithGeneralPortResourcePresentBothInGivenAndRequested(t *testing.T
 func TestTradeWithResourcePortSuccess(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -438,33 +439,33 @@ func TestTradeWithResourcePortSuccess(t *testing.T) {
 	)
 
 	t.Run("trade with resource port - success", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 4,
-			"Brick":  2,
-		}, map[string]int{
-			"Sheep": 1,
-			"Grain": 1,
-			"Ore":   1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 4,
+			Brick:  2,
+		}, coreT.Resources{
+			Sheep: 1,
+			Grain: 1,
+			Ore:   1,
 		})
 		if err != nil {
 			t.Errorf("expected to make port trades just fine, but actually got error %s", err.Error())
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 0 {
-			t.Errorf("expected player#1 to have 0 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 0 {
+			t.Errorf("expected player#1 to have 0 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 1 {
-			t.Errorf("expected player#1 to have 1 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 1 {
+			t.Errorf("expected player#1 to have 1 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 2 {
-			t.Errorf("expected player#1 to have 2 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 2 {
+			t.Errorf("expected player#1 to have 2 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 2 {
-			t.Errorf("expected player#1 to have 2 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 2 {
+			t.Errorf("expected player#1 to have 2 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 2 {
-			t.Errorf("expected player#1 to have 2 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 2 {
+			t.Errorf("expected player#1 to have 2 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -472,13 +473,13 @@ func TestTradeWithResourcePortSuccess(t *testing.T) {
 func TestTradeWithResourcePortNotAvailablePort(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -487,33 +488,33 @@ func TestTradeWithResourcePortNotAvailablePort(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - doesn't own required port", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 4,
-			"Brick":  2,
-		}, map[string]int{
-			"Sheep": 1,
-			"Grain": 1,
-			"Ore":   1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 4,
+			Brick:  2,
+		}, coreT.Resources{
+			Sheep: 1,
+			Grain: 1,
+			Ore:   1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -521,13 +522,13 @@ func TestTradeWithResourcePortNotAvailablePort(t *testing.T) {
 func TestTradeWithResourcePortNotAvailableResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -536,33 +537,33 @@ func TestTradeWithResourcePortNotAvailableResources(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - doesn't have required resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 4,
-			"Brick":  4,
-		}, map[string]int{
-			"Sheep": 1,
-			"Grain": 1,
-			"Ore":   2,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 4,
+			Brick:  4,
+		}, coreT.Resources{
+			Sheep: 1,
+			Grain: 1,
+			Ore:   2,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -571,13 +572,13 @@ func TestTradeWithResourcePortByRound(t *testing.T) {
 	createGame := func(roundType round.Type) *GameState {
 		game := CreateTestGame(
 			MockWithRoundType(roundType),
-			MockWithResourcesByPlayer(map[string]map[string]int{
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
 				"1": {
-					"Lumber": 2,
-					"Brick":  1,
-					"Sheep":  1,
-					"Grain":  1,
-					"Ore":    1,
+					Lumber: 2,
+					Brick:  1,
+					Sheep:  1,
+					Grain:  1,
+					Ore:    1,
 				},
 			}),
 			MockWithPortsByPlayer(map[string][]string{
@@ -609,7 +610,7 @@ func TestTradeWithResourcePortByRound(t *testing.T) {
 		testname := fmt.Sprintf("round type: %d, will have error: %v", roundType, willHaveError)
 		t.Run(testname, func(t *testing.T) {
 			game := createGame(roundType)
-			err := game.MakeResourcePortTrade("1", map[string]int{"Lumber": 2}, map[string]int{"Ore": 1})
+			err := game.MakeResourcePortTrade("1", coreT.Resources{Lumber: 2}, coreT.Resources{Ore: 1})
 			hasErr := err != nil
 			if hasErr != willHaveError {
 				t.Errorf("expected error to be %v, but actually was %v", willHaveError, hasErr)
@@ -621,13 +622,13 @@ func TestTradeWithResourcePortByRound(t *testing.T) {
 func TestTradeWithResourcePortNotPlayerRound(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"2": {
-				"Lumber": 2,
-				"Brick":  1,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 2,
+				Brick:  1,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -636,7 +637,7 @@ func TestTradeWithResourcePortNotPlayerRound(t *testing.T) {
 	)
 
 	t.Run("trade with resource port - not player's round", func(t *testing.T) {
-		err := game.MakeGeneralPortTrade("2", map[string]int{"Lumber": 2}, map[string]int{"Ore": 1})
+		err := game.MakeGeneralPortTrade("2", coreT.Resources{Lumber: 2}, coreT.Resources{Ore: 1})
 		if err == nil {
 			t.Errorf("expected to not be able to trade with port during other player's round, but traded just fine")
 		}
@@ -646,13 +647,13 @@ func TestTradeWithResourcePortNotPlayerRound(t *testing.T) {
 func TestTradeWithResourcePortIncorrectNumberOfGivenResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -661,32 +662,32 @@ func TestTradeWithResourcePortIncorrectNumberOfGivenResources(t *testing.T) {
 	)
 
 	t.Run("trade with resource port fail - incorrect number of given resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 3,
-			"Brick":  2,
-		}, map[string]int{
-			"Sheep": 1,
-			"Grain": 1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 3,
+			Brick:  2,
+		}, coreT.Resources{
+			Sheep: 1,
+			Grain: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -694,13 +695,13 @@ func TestTradeWithResourcePortIncorrectNumberOfGivenResources(t *testing.T) {
 func TestTradeWithResourcePortIncorrectNumberOfRequestedResources(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -709,32 +710,32 @@ func TestTradeWithResourcePortIncorrectNumberOfRequestedResources(t *testing.T)
 	)
 
 	t.Run("trade with resource port fail - incorrect number of requested resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 4,
-			"Brick":  2,
-		}, map[string]int{
-			"Sheep": 1,
-			"Grain": 1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 4,
+			Brick:  2,
+		}, coreT.Resources{
+			Sheep: 1,
+			Grain: 1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -742,13 +743,13 @@ func TestTradeWithResourcePortIncorrectNumberOfRequestedResources(t *testing.T)
 func TestTradeWithResourcePortResourcePresentBothInGivenAndRequested(t *testing.T) {
 	game := CreateTestGame(
 		MockWithRoundType(round.Regular),
-		MockWithResourcesByPlayer(map[string]map[string]int{
+		MockWithResourcesByPlayer(map[string]coreT.Resources{
 			"1": {
-				"Lumber": 4,
-				"Brick":  3,
-				"Sheep":  1,
-				"Grain":  1,
-				"Ore":    1,
+				Lumber: 4,
+				Brick:  3,
+				Sheep:  1,
+				Grain:  1,
+				Ore:    1,
 			},
 		}),
 		MockWithPortsByPlayer(map[string][]string{
@@ -757,33 +758,33 @@ func TestTradeWithResourcePortResourcePresentBothInGivenAndRequested(t *testing.
 	)
 
 	t.Run("trade with resource port fail - incorrect number of given resources", func(t *testing.T) {
-		err := game.MakeResourcePortTrade("1", map[string]int{
-			"Lumber": 4,
-			"Brick":  2,
-		}, map[string]int{
-			"Lumber": 1,
-			"Sheep":  1,
-			"Grain":  1,
+		err := game.MakeResourcePortTrade("1", coreT.Resources{
+			Lumber: 4,
+			Brick:  2,
+		}, coreT.Resources{
+			Lumber: 1,
+			Sheep:  1,
+			Grain:  1,
 		})
 		if err == nil {
 			t.Errorf("expected to not be able to make port trade, but it actually got no error")
 		}
 
 		player1Resources := game.ResourceHandByPlayer("1")
-		if player1Resources["Lumber"] != 4 {
-			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources["Lumber"])
+		if player1Resources.Lumber != 4 {
+			t.Errorf("expected player#1 to have 4 Lumber after port trades, actually got %d", player1Resources.Lumber)
 		}
-		if player1Resources["Brick"] != 3 {
-			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources["Brick"])
+		if player1Resources.Brick != 3 {
+			t.Errorf("expected player#1 to have 3 Brick after port trades, actually got %d", player1Resources.Brick)
 		}
-		if player1Resources["Sheep"] != 1 {
-			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources["Sheep"])
+		if player1Resources.Sheep != 1 {
+			t.Errorf("expected player#1 to have 1 Sheep after port trades, actually got %d", player1Resources.Sheep)
 		}
-		if player1Resources["Grain"] != 1 {
-			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources["Grain"])
+		if player1Resources.Grain != 1 {
+			t.Errorf("expected player#1 to have 1 Grain after port trades, actually got %d", player1Resources.Grain)
 		}
-		if player1Resources["Ore"] != 1 {
-			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources["Ore"])
+		if player1Resources.Ore != 1 {
+			t.Errorf("expected player#1 to have 1 Ore after port trades, actually got %d", player1Resources.Ore)
 		}
 	})
 }
@@ -0,0 +1,327 @@
+package core
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// Two-player variant:
+// - two neutral players get settlements before the setup phase and a free road whenever a player builds a road or a settlement
+// - players earn trade tokens for settlements next to the desert or on the coast,
+// spending them to force a trade with the opponent or to move the robber back to the desert
+// - dice are rolled twice each turn and the second roll never repeats the first one
+
+var neutralPlayers = []coreT.Player{
+	{
+		ID:    "neutral-1",
+		Color: coreT.PlayerColor{Background: "dimgray", Foreground: "white"},
+	},
+	{
+		ID:    "neutral-2",
+		Color: coreT.PlayerColor{Background: "tan", Foreground: "black"},
+	},
+}
+
+const (
+	neutralSettlements  = 2
+	startingTradeTokens = 5
+	desertTradeTokens   = 2
+	coastTradeTokens    = 1
+	tradeTokenCost      = 1
+	// Whoever leads on points pays more
+	leaderTradeTokenCost = 2
+	forcedTradeCards     = 2
+)
+
+func (state *GameState) NeutralPlayers() []coreT.Player {
+	if !state.twoPlayer {
+		return []coreT.Player{}
+	}
+	return append([]coreT.Player{}, neutralPlayers...)
+}
+
+func (state *GameState) TradeTokens() map[string]int {
+	return maps.Clone(state.tradeTokens)
+}
+
+func (state *GameState) TradeTokenCost(playerID string) int {
+	for _, player := range state.players {
+		if player.ID != playerID && state.points[playerID] > state.points[player.ID] {
+			return leaderTradeTokenCost
+		}
+	}
+	return tradeTokenCost
+}
+
+// placeNeutralPlayers gives each neutral player its settlements, each with a road, at random spots respecting the distance rule
+func (state *GameState) placeNeutralPlayers() {
+	for i := 0; i < neutralSettlements; i++ {
+		for _, neutral := range neutralPlayers {
+			candidates := make([]int, 0)
+			for _, vertexID := range slices.Sorted(maps.Keys(state.board.Definition.TilesByVertex)) {
+				if state.hasBuildingAt(vertexID) || state.isVertexBlocked(vertexID) {
+					continue
+				}
+				candidates = append(candidates, vertexID)
+			}
+			if len(candidates) == 0 {
+				return
+			}
+			vertexID := candidates[state.rand.Intn(len(candidates))]
+			state.board.AddSettlement(neutral.ID, vertexID)
+
+			edges := state.board.Definition.EdgesByVertex[vertexID]
+			state.board.AddRoad(neutral.ID, edges[state.rand.Intn(len(edges))])
+		}
+	}
+}
+
+// growNeutralRoad builds a road for the neutral player with fewer roads, at a random spot connected to its network
+func (state *GameState) growNeutralRoad() {
+	roadsByNeutral := make(map[string]int)
+	for _, road := range state.board.GetRoads() {
+		roadsByNeutral[road.Owner]++
+	}
+	neutrals := append([]coreT.Player{}, neutralPlayers...)
+	slices.SortStableFunc(neutrals, func(a, b coreT.Player) int {
+		return roadsByNeutral[a.ID] - roadsByNeutral[b.ID]
+	})
+
+	for _, neutral := range neutrals {
+		if roadsByNeutral[neutral.ID] >= state.maxRoads {
+			continue
+		}
+		edges := state.neutralAvailableEdges(neutral.ID)
+		if len(edges) == 0 {
+			continue
+		}
+		edgeID := edges[state.rand.Intn(len(edges))]
+		state.board.AddRoad(neutral.ID, edgeID)
+		state.emit(RoadBuilt{PlayerID: neutral.ID, EdgeID: edgeID})
+		return
+	}
+}
+
+func (state *GameState) neutralAvailableEdges(neutralID string) []int {
+	roads := state.board.GetRoads()
+	ends := make(map[int]bool)
+	for vertexID, settlement := range state.board.GetSettlements() {
+		if settlement.Owner == neutralID {
+			ends[vertexID] = true
+		}
+	}
+	for edgeID, road := range roads {
+		if road.Owner != neutralID {
+			continue
+		}
+		for _, vertexID := range state.board.Definition.VerticesByEdge[edgeID] {
+			// Roads cannot go past other players' buildings
+			if owner, exists := state.buildingOwnerAt(vertexID); exists && owner != neutralID {
+				continue
+			}
+			ends[vertexID] = true
+		}
+	}
+
+	ships := state.board.GetShips()
+	edges := make(map[int]bool)
+	for vertexID := range ends {
+		for _, edgeID := range state.board.Definition.EdgesByVertex[vertexID] {
+			_, hasRoad := roads[edgeID]
+			_, hasShip := ships[edgeID]
+			if !hasRoad && !hasShip && !state.isSeaEdge(edgeID) {
+				edges[edgeID] = true
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(edges))
+}
+
+func (state *GameState) hasBuildingAt(vertexID int) bool {
+	_, exists := state.buildingOwnerAt(vertexID)
+	return exists
+}
+
+func (state *GameState) buildingOwnerAt(vertexID int) (string, bool) {
+	if settlement, exists := state.board.GetSettlements()[vertexID]; exists {
+		return settlement.Owner, true
+	}
+	if city, exists := state.board.GetCities()[vertexID]; exists {
+		return city.Owner, true
+	}
+	return "", false
+}
+
+func (state *GameState) awardTradeTokens(playerID string, vertexID int) {
+	tiles := state.board.GetTiles()
+	tilesIndexes := state.board.Definition.TilesByVertex[vertexID]
+	for _, index := range tilesIndexes {
+		if tiles[index].Resource == "Desert" {
+			state.tradeTokens[playerID] += desertTradeTokens
+			break
+		}
+	}
+	if len(tilesIndexes) < 3 {
+		state.tradeTokens[playerID] += coastTradeTokens
+	}
+}
+
+// rollSecondDice rolls again, to a sum that differs from the first roll's, returning it
+func (state *GameState) rollSecondDice(playerID string, firstSum int) int {
+	dice1, dice2 := state.dice.RollExcluding(state.rand, playerID, firstSum)
+	sum := dice1 + dice2
+	state.round.SetSecondDice(dice1, dice2)
+	state.bookKeeping.AddDiceEntry(playerID, sum)
+	return sum
+}
+
+func (state *GameState) payTradeTokens(playerID string) error {
+	cost := state.TradeTokenCost(playerID)
+	if state.tradeTokens[playerID] < cost {
+		err := fmt.Errorf("Insufficient trade tokens: %d needed, but player has %d", cost, state.tradeTokens[playerID])
+		return err
+	}
+	state.tradeTokens[playerID] -= cost
+	return nil
+}
+
+// ForceTrade takes forcedTradeCards random cards from targetPlayerID, who gets givenResources in return
+func (state *GameState) ForceTrade(playerID, targetPlayerID string, givenResources coreT.Resources) (err error) {
+	defer state.record(Command{Type: CommandForceTrade, PlayerID: playerID, TargetPlayerID: targetPlayerID, Given: givenResources})(&err)
+
+	if !state.twoPlayer {
+		err := fmt.Errorf("Cannot force trade outside the two-player variant")
+		return err
+	}
+
+	if playerID != state.currentPlayer().ID {
+		err := fmt.Errorf("Cannot force trade during other player's turn")
+		return err
+	}
+
+	if state.round.GetRoundType() != round.Regular {
+		err := fmt.Errorf("Cannot force trade during %s", state.round.GetCurrentRoundTypeDescription())
+		return err
+	}
+
+	targetState, exists := state.playersStates[targetPlayerID]
+	if !exists || targetPlayerID == playerID {
+		err := fmt.Errorf("Cannot force trade with %s", targetPlayerID)
+		return err
+	}
+
+	if err := givenResources.Validate(); err != nil {
+		return err
+	}
+	if givenResources.Total() != forcedTradeCards {
+		err := fmt.Errorf("Cannot force trade: must give exactly %d cards", forcedTradeCards)
+		return err
+	}
+
+	playerState := state.playersStates[playerID]
+	if !playerState.GetResources().Covers(givenResources) {
+		err := fmt.Errorf("Insufficient resources to force trade")
+		return err
+	}
+
+	if targetState.GetResources().Total() < forcedTradeCards {
+		err := fmt.Errorf("Cannot force trade with a player with less than %d cards", forcedTradeCards)
+		return err
+	}
+
+	if err := state.payTradeTokens(playerID); err != nil {
+		return err
+	}
+
+	var taken coreT.Resources
+	for i := 0; i < forcedTradeCards; i++ {
+		cards := make([]coreT.Resource, 0)
+		for _, resource := range ResourcesOrder {
+			quantity := targetState.GetResources().Get(resource)
+			for j := 0; j < quantity; j++ {
+				cards = append(cards, resource)
+			}
+		}
+		resource := cards[state.rand.Intn(len(cards))]
+		targetState.RemoveResource(resource, 1)
+		taken = taken.Add(coreT.ResourcesOf(resource, 1))
+	}
+
+	playerState.RemoveResources(givenResources)
+	playerState.AddResources(taken)
+	targetState.AddResources(givenResources)
+	state.emit(TradeForced{PlayerID: playerID, TargetPlayerID: targetPlayerID, Taken: taken, Given: givenResources})
+	return nil
+}
+
+// robberDesertIndex finds the tile MoveRobberToDesert would move the robber to
+func (state *GameState) robberDesertIndex() (int, error) {
+	desertIndex := -1
+	for i, tile := range state.board.GetTiles() {
+		if tile.Resource != "Desert" {
+			continue
+		}
+		if tile.Blocked {
+			err := fmt.Errorf("Cannot move robber to the desert: it is already there")
+			return -1, err
+		}
+		if desertIndex == -1 {
+			desertIndex = i
+		}
+	}
+	if desertIndex == -1 {
+		err := fmt.Errorf("Cannot move robber to the desert: map has no desert")
+		return -1, err
+	}
+	return desertIndex, nil
+}
+
+func (state *GameState) canMoveRobberToDesert(playerID string) bool {
+	if !state.twoPlayer || state.tradeTokens[playerID] < state.TradeTokenCost(playerID) {
+		return false
+	}
+	_, err := state.robberDesertIndex()
+	return err == nil
+}
+
+// MoveRobberToDesert spends trade tokens to send the robber back to the desert, without robbing anyone
+func (state *GameState) MoveRobberToDesert(playerID string) (err error) {
+	defer state.record(Command{Type: CommandMoveRobberToDesert, PlayerID: playerID})(&err)
+
+	if !state.twoPlayer {
+		err := fmt.Errorf("Cannot move robber to the desert outside the two-player variant")
+		return err
+	}
+
+	if playerID != state.currentPlayer().ID {
+		err := fmt.Errorf("Cannot move robber to the desert during other player's turn")
+		return err
+	}
+
+	roundType := state.round.GetRoundType()
+	if roundType != round.Regular && roundType != round.BetweenTurns {
+		err := fmt.Errorf("Cannot move robber to the desert during %s", state.round.GetCurrentRoundTypeDescription())
+		return err
+	}
+
+	desertIndex, err := state.robberDesertIndex()
+	if err != nil {
+		return err
+	}
+
+	if err := state.payTradeTokens(playerID); err != nil {
+		return err
+	}
+
+	for i, tile := range state.board.GetTiles() {
+		if tile.Blocked {
+			state.board.UnblockTileByIndex(i)
+		}
+	}
+	state.board.BlockTileByIndex(desertIndex)
+	return nil
+}
@@ -0,0 +1,257 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/dice"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+// twoPlayerVariant plays base4 with the two-player variant
+func twoPlayerVariant(game *seededTestGame) {
+	game.numberOfPlayers = 2
+	game.params.TwoPlayer = 1
+}
+
+// freeVertex finds a vertex a settlement can be built at, optionally on the coast and away from the desert
+func freeVertex(game *GameState, coastal bool) int {
+	tiles := game.board.GetTiles()
+	for vertexID := 1; vertexID <= len(game.board.Definition.TilesByVertex); vertexID++ {
+		tilesIndexes := game.board.Definition.TilesByVertex[vertexID]
+		if game.hasBuildingAt(vertexID) || game.isVertexBlocked(vertexID) {
+			continue
+		}
+		if coastal != (len(tilesIndexes) < 3) {
+			continue
+		}
+		nextToDesert := false
+		for _, index := range tilesIndexes {
+			nextToDesert = nextToDesert || tiles[index].Resource == "Desert"
+		}
+		if !nextToDesert {
+			return vertexID
+		}
+	}
+	return -1
+}
+
+func neutralRoads(game *GameState) int {
+	count := 0
+	for _, road := range game.GetAllRoads() {
+		if road.Owner == neutralPlayers[0].ID || road.Owner == neutralPlayers[1].ID {
+			count++
+		}
+	}
+	return count
+}
+
+func TestTwoPlayerRequiresTwoPlayers(t *testing.T) {
+	_, err := newSeededTestGame(1, twoPlayerVariant, onMap("base4", 3))
+	if err == nil {
+		t.Errorf("expected to have error since two-player variant needs exactly 2 players, but actually no error was found")
+	}
+}
+
+func TestTwoPlayerNeutralPlayers(t *testing.T) {
+	game := createSeededTestGame(t, 42, twoPlayerVariant)
+
+	settlementsByOwner := make(map[string]int)
+	for _, settlement := range game.GetAllSettlements() {
+		settlementsByOwner[settlement.Owner]++
+	}
+	for _, neutral := range game.NeutralPlayers() {
+		if settlementsByOwner[neutral.ID] != neutralSettlements {
+			t.Errorf("expected %s to have %d settlements, actually got %d", neutral.ID, neutralSettlements, settlementsByOwner[neutral.ID])
+		}
+	}
+	if neutralRoads(game) != 2*neutralSettlements {
+		t.Errorf("expected neutral players to have %d roads, actually got %d", 2*neutralSettlements, neutralRoads(game))
+	}
+	if violations := game.Validate(); violations != nil {
+		t.Errorf("expected neutral settlements to respect the rules, but got %s", violations.Error())
+	}
+	for _, player := range game.Players() {
+		if game.TradeTokens()[player.ID] != startingTradeTokens {
+			t.Errorf("expected player %s to start with %d trade tokens, actually got %d", player.ID, startingTradeTokens, game.TradeTokens()[player.ID])
+		}
+	}
+
+	t.Run("neutral players gain a road when a player builds one", func(t *testing.T) {
+		vertexID := freeVertex(game, false)
+		edgeID := game.board.Definition.EdgesByVertex[vertexID][0]
+		MockWithSettlementsByPlayer(map[string][]int{"1": {vertexID}})(game)
+		MockWithResourcesByPlayer(map[string]coreT.Resources{"1": {Lumber: 1, Brick: 1}})(game)
+		MockWithRoundType(round.Regular)(game)
+
+		roadsBefore := neutralRoads(game)
+		err := game.BuildRoad("1", edgeID)
+		if err != nil {
+			t.Fatalf("expected to build road just fine, but actually got error %s", err.Error())
+		}
+		if neutralRoads(game) != roadsBefore+1 {
+			t.Errorf("expected neutral players to have %d roads, actually got %d", roadsBefore+1, neutralRoads(game))
+		}
+	})
+
+	t.Run("neutral players cannot be robbed", func(t *testing.T) {
+		for vertexID, settlement := range game.GetAllSettlements() {
+			if settlement.Owner != neutralPlayers[0].ID {
+				continue
+			}
+			tileIndex := game.board.Definition.TilesByVertex[vertexID][0]
+			MockWithBlockedTile(game.board.GetTiles()[tileIndex].ID)(game)
+			break
+		}
+		MockWithRoundType(round.PickRobbed)(game)
+		robbablePlayers, _ := game.RobbablePlayers("1")
+		for _, playerID := range robbablePlayers {
+			if playerID == neutralPlayers[0].ID || playerID == neutralPlayers[1].ID {
+				t.Errorf("expected neutral players not to be robbable, actually got %v", robbablePlayers)
+			}
+		}
+	})
+}
+
+func TestTwoPlayerTradeTokensFromCoast(t *testing.T) {
+	game := createSeededTestGame(t, 42, twoPlayerVariant)
+
+	err := game.BuildSettlement("1", freeVertex(game, true))
+	if err != nil {
+		t.Fatalf("expected to build settlement just fine, but actually got error %s", err.Error())
+	}
+	expected := startingTradeTokens + coastTradeTokens
+	if game.TradeTokens()["1"] != expected {
+		t.Errorf("expected player 1 to have %d trade tokens after building on the coast, actually got %d", expected, game.TradeTokens()["1"])
+	}
+}
+
+func TestTwoPlayerDiceRolls(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		game := createSeededTestGame(t, seed, twoPlayerVariant, withMocks(MockWithRoundType(round.BetweenTurns)))
+		err := game.RollDice("1")
+		if err != nil {
+			t.Fatalf("expected to roll dice just fine, but actually got error %s", err.Error())
+		}
+		dice := game.Dice()
+		secondDice := game.SecondDice()
+		if secondDice[0] == 0 || secondDice[1] == 0 {
+			t.Fatalf("expected dice to be rolled twice, but second roll is %v", secondDice)
+		}
+		if dice[0]+dice[1] == secondDice[0]+secondDice[1] {
+			t.Errorf("expected second roll to differ from the first one, but both add up to %d", dice[0]+dice[1])
+		}
+	}
+}
+
+func TestTwoPlayerEventDeck(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		game := createSeededTestGame(t, seed, twoPlayerVariant, withDice(dice.EventDeck, 0), withMocks(MockWithRoundType(round.BetweenTurns)))
+		err := game.RollDice("1")
+		if err != nil {
+			t.Fatalf("expected to roll dice just fine, but actually got error %s", err.Error())
+		}
+
+		// Both rolls together with the cards left make up the whole deck
+		cardsLeft := game.dice.Snapshot().Cards
+		if len(cardsLeft) != 34 {
+			t.Fatalf("expected 34 cards left after drawing two, actually got %d", len(cardsLeft))
+		}
+		firstDice, secondDice := game.Dice(), game.SecondDice()
+		cards := append(cardsLeft, [2]int{firstDice[0], firstDice[1]}, [2]int{secondDice[0], secondDice[1]})
+		seen := make(map[[2]int]bool)
+		for _, card := range cards {
+			if seen[card] {
+				t.Fatalf("expected every outcome once in the deck, but %v came up twice", card)
+			}
+			seen[card] = true
+		}
+	}
+}
+
+func TestTwoPlayerForceTrade(t *testing.T) {
+	createGame := func() *GameState {
+		return createSeededTestGame(t, 42, twoPlayerVariant, withMocks(
+			MockWithRoundType(round.Regular),
+			MockWithResourcesByPlayer(map[string]coreT.Resources{
+				"1": {Lumber: 2, Brick: 1},
+				"2": {Ore: 3},
+			}),
+		))
+	}
+
+	t.Run("force trade success", func(t *testing.T) {
+		game := createGame()
+		err := game.ForceTrade("1", "2", coreT.Resources{Lumber: 2})
+		if err != nil {
+			t.Fatalf("expected to force trade just fine, but actually got error %s", err.Error())
+		}
+		expected := coreT.Resources{Brick: 1, Ore: 2}
+		if game.ResourceHandByPlayer("1") != expected {
+			t.Errorf("expected player 1 hand to be %s, actually got %s", expected, game.ResourceHandByPlayer("1"))
+		}
+		expected = coreT.Resources{Lumber: 2, Ore: 1}
+		if game.ResourceHandByPlayer("2") != expected {
+			t.Errorf("expected player 2 hand to be %s, actually got %s", expected, game.ResourceHandByPlayer("2"))
+		}
+		if game.TradeTokens()["1"] != startingTradeTokens-tradeTokenCost {
+			t.Errorf("expected player 1 to have %d trade tokens, actually got %d", startingTradeTokens-tradeTokenCost, game.TradeTokens()["1"])
+		}
+	})
+
+	t.Run("force trade giving the wrong number of cards", func(t *testing.T) {
+		game := createGame()
+		err := game.ForceTrade("1", "2", coreT.Resources{Lumber: 1})
+		if err == nil {
+			t.Errorf("expected to have error since exactly %d cards must be given, but actually no error was found", forcedTradeCards)
+		}
+	})
+
+	t.Run("force trade without trade tokens", func(t *testing.T) {
+		game := createGame()
+		game.tradeTokens["1"] = 0
+		err := game.ForceTrade("1", "2", coreT.Resources{Lumber: 2})
+		if err == nil {
+			t.Errorf("expected to have error since player has no trade tokens, but actually no error was found")
+		}
+	})
+
+	t.Run("force trade outside the two-player variant", func(t *testing.T) {
+		game := CreateTestGame(MockWithRoundType(round.Regular))
+		err := game.ForceTrade("1", "2", coreT.Resources{Lumber: 2})
+		if err == nil {
+			t.Errorf("expected to have error since game isn't two-player, but actually no error was found")
+		}
+	})
+}
+
+func TestTwoPlayerMoveRobberToDesert(t *testing.T) {
+	game := createSeededTestGame(t, 42, twoPlayerVariant, withMocks(MockWithRoundType(round.Regular)))
+	for _, tile := range game.GetBoard() {
+		if tile.Resource != "Desert" {
+			MockWithBlockedTile(tile.ID)(game)
+			break
+		}
+	}
+	desertIndex, err := game.robberDesertIndex()
+	if err != nil {
+		t.Fatalf("expected robber to be away from the desert, but actually got error %s", err.Error())
+	}
+
+	err = game.MoveRobberToDesert("1")
+	if err != nil {
+		t.Fatalf("expected to move robber to the desert just fine, but actually got error %s", err.Error())
+	}
+	blocked := game.BlockedTiles()
+	if len(blocked) != 1 || blocked[0] != game.GetBoard()[desertIndex].ID {
+		t.Errorf("expected only the desert to be blocked, actually got %v", blocked)
+	}
+	if game.TradeTokens()["1"] != startingTradeTokens-tradeTokenCost {
+		t.Errorf("expected player 1 to have %d trade tokens, actually got %d", startingTradeTokens-tradeTokenCost, game.TradeTokens()["1"])
+	}
+
+	err = game.MoveRobberToDesert("1")
+	if err == nil {
+		t.Errorf("expected to have error since robber is already at the desert, but actually no error was found")
+	}
+}
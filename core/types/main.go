@@ -14,19 +14,27 @@ func (h HexCoordinate) String() string {
 
 // FIXME: redundant for now
 type Settings struct {
-	BankTradeAmount      int
-	MaxCards             int
-	MaxDevCardsPerRound  int
-	MaxSettlements       int
-	MaxCities            int
-	MaxRoads             int
-	TargetPoint          int
-	PointsPerSettlement  int
-	PointsPerCity        int
-	PointsForMostKnights int
-	PointsForLongestRoad int
-	MostKnightsMinimum   int
-	LongestRoadMinimum   int
+	BankTradeAmount        int
+	MaxCards               int
+	MaxDevCardsPerRound    int
+	MaxSettlements         int
+	MaxCities              int
+	MaxRoads               int
+	MaxShips               int
+	TargetPoint            int
+	PointsPerSettlement    int
+	PointsPerCity          int
+	PointsForMostKnights   int
+	PointsForLongestRoad   int
+	MostKnightsMinimum     int
+	LongestRoadMinimum     int
+	BankSupply             int
+	SpecialBuildPhase      bool
+	TwoPlayer              bool
+	FogOfWar               bool
+	ExplorationReward      bool
+	FriendlyRobber         int
+	RobberProtectionRounds int
 }
 
 type MapBlock struct {
@@ -37,6 +45,8 @@ type MapBlock struct {
 	Edges       [6]int        `json:"edges"`
 	Coordinates HexCoordinate `json:"coordinates"`
 	Blocked     bool          `json:"blocked"`
+	// Unexplored tile of a fog-of-war game
+	Hidden bool `json:"hidden,omitempty"`
 }
 
 type Port struct {
@@ -58,3 +68,8 @@ type DevelopmentCard struct {
 	Name        string `json:"name"`
 	RoundBought int    `json:"-"`
 }
+
+type DevelopmentCardSnapshot struct {
+	Name        string `json:"name"`
+	RoundBought int    `json:"roundBought"`
+}
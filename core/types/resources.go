@@ -0,0 +1,190 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Resource is one of the five kinds of resource cards
+type Resource string
+
+const (
+	Lumber Resource = "Lumber"
+	Brick  Resource = "Brick"
+	Sheep  Resource = "Sheep"
+	Grain  Resource = "Grain"
+	Ore    Resource = "Ore"
+)
+
+// ResourcesOrder is the order resources are listed and iterated in
+var ResourcesOrder = [5]Resource{Lumber, Brick, Sheep, Grain, Ore}
+
+func ParseResource(name string) (Resource, error) {
+	resource := Resource(name)
+	if err := resource.Validate(); err != nil {
+		return "", err
+	}
+	return resource, nil
+}
+
+func (r Resource) Validate() error {
+	switch r {
+	case Lumber, Brick, Sheep, Grain, Ore:
+		return nil
+	}
+	err := fmt.Errorf("Unknown resource '%s'", string(r))
+	return err
+}
+
+func (r *Resource) UnmarshalText(text []byte) error {
+	resource, err := ParseResource(string(text))
+	if err != nil {
+		return err
+	}
+	*r = resource
+	return nil
+}
+
+// Resources counts cards of each resource: a hand, a cost, one side of a trade...
+// It's encoded as an object keyed by resource name
+type Resources struct {
+	Lumber int `json:"Lumber"`
+	Brick  int `json:"Brick"`
+	Sheep  int `json:"Sheep"`
+	Grain  int `json:"Grain"`
+	Ore    int `json:"Ore"`
+}
+
+var (
+	RoadCost            = Resources{Lumber: 1, Brick: 1}
+	ShipCost            = Resources{Lumber: 1, Sheep: 1}
+	SettlementCost      = Resources{Lumber: 1, Brick: 1, Sheep: 1, Grain: 1}
+	CityCost            = Resources{Grain: 2, Ore: 3}
+	DevelopmentCardCost = Resources{Sheep: 1, Grain: 1, Ore: 1}
+)
+
+// NewResources builds Resources out of quantities keyed by resource name,
+// rejecting unknown names and negative quantities
+func NewResources(quantities map[string]int) (Resources, error) {
+	var resources Resources
+	for name, quantity := range quantities {
+		resource, err := ParseResource(name)
+		if err != nil {
+			return Resources{}, err
+		}
+		resources = resources.Add(ResourcesOf(resource, quantity))
+	}
+	if err := resources.Validate(); err != nil {
+		return Resources{}, err
+	}
+	return resources, nil
+}
+
+// ResourcesOf returns Resources holding only quantity cards of resource
+func ResourcesOf(resource Resource, quantity int) Resources {
+	var resources Resources
+	if field := resources.field(resource); field != nil {
+		*field = quantity
+	}
+	return resources
+}
+
+func (r *Resources) field(resource Resource) *int {
+	switch resource {
+	case Lumber:
+		return &r.Lumber
+	case Brick:
+		return &r.Brick
+	case Sheep:
+		return &r.Sheep
+	case Grain:
+		return &r.Grain
+	case Ore:
+		return &r.Ore
+	}
+	return nil
+}
+
+func (r Resources) Get(resource Resource) int {
+	if field := r.field(resource); field != nil {
+		return *field
+	}
+	return 0
+}
+
+func (r Resources) Add(other Resources) Resources {
+	return Resources{
+		Lumber: r.Lumber + other.Lumber,
+		Brick:  r.Brick + other.Brick,
+		Sheep:  r.Sheep + other.Sheep,
+		Grain:  r.Grain + other.Grain,
+		Ore:    r.Ore + other.Ore,
+	}
+}
+
+func (r Resources) Subtract(other Resources) Resources {
+	return Resources{
+		Lumber: r.Lumber - other.Lumber,
+		Brick:  r.Brick - other.Brick,
+		Sheep:  r.Sheep - other.Sheep,
+		Grain:  r.Grain - other.Grain,
+		Ore:    r.Ore - other.Ore,
+	}
+}
+
+// Covers tells whether there are at least as many cards of each resource as in other
+func (r Resources) Covers(other Resources) bool {
+	for _, resource := range ResourcesOrder {
+		if r.Get(resource) < other.Get(resource) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Resources) Total() int {
+	return r.Lumber + r.Brick + r.Sheep + r.Grain + r.Ore
+}
+
+func (r Resources) IsZero() bool {
+	return r == Resources{}
+}
+
+// Validate rejects negative quantities
+func (r Resources) Validate() error {
+	for _, resource := range ResourcesOrder {
+		if quantity := r.Get(resource); quantity < 0 {
+			err := fmt.Errorf("Invalid quantity %d of %s", quantity, resource)
+			return err
+		}
+	}
+	return nil
+}
+
+// String lists the non empty quantities, e.g. "2 Grain, 3 Ore"
+func (r Resources) String() string {
+	parts := make([]string, 0, len(ResourcesOrder))
+	for _, resource := range ResourcesOrder {
+		if quantity := r.Get(resource); quantity != 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", quantity, resource))
+		}
+	}
+	if len(parts) == 0 {
+		return "nothing"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (r *Resources) UnmarshalJSON(data []byte) error {
+	var quantities map[string]int
+	if err := json.Unmarshal(data, &quantities); err != nil {
+		return err
+	}
+	resources, err := NewResources(quantities)
+	if err != nil {
+		return err
+	}
+	*r = resources
+	return nil
+}
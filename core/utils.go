@@ -3,18 +3,16 @@ package core
 import (
 	"math"
 
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/utils"
 )
 
-var ResourcesOrder [5]string = [5]string{"Lumber", "Brick", "Sheep", "Grain", "Ore"}
+var ResourcesOrder = coreT.ResourcesOrder
 
 func (state *GameState) NumberOfResourcesByPlayer() map[string]int {
 	resourcesByPlayer := make(map[string]int)
 	for player, pState := range state.playersStates {
-		resourcesByPlayer[player] = 0
-		for _, count := range pState.GetResources() {
-			resourcesByPlayer[player] += count
-		}
+		resourcesByPlayer[player] = pState.GetResources().Total()
 	}
 	return resourcesByPlayer
 }
@@ -31,10 +29,7 @@ func (state *GameState) NumberOfDevCardsByPlayer() map[string]int {
 }
 
 func (state *GameState) discardAmountByPlayer(playerID string) int {
-	total := 0
-	for _, count := range state.playersStates[playerID].GetResources() {
-		total += count
-	}
+	total := state.playersStates[playerID].GetResources().Total()
 	if total <= state.maxCards {
 		return 0
 	}
@@ -0,0 +1,349 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+const (
+	InvariantResources        = "resources"
+	InvariantBuildings        = "buildings"
+	InvariantDistanceRule     = "distance rule"
+	InvariantRoadConnectivity = "road connectivity"
+	InvariantDevelopmentCards = "development cards"
+	InvariantPoints           = "points"
+)
+
+// InvariantViolation describes a state that no sequence of valid moves can reach.
+// PlayerID, VertexID and EdgeID point to the offender when applicable
+type InvariantViolation struct {
+	Invariant string
+	PlayerID  string
+	VertexID  int
+	EdgeID    int
+	Message   string
+}
+
+func (v *InvariantViolation) Error() string {
+	offenders := make([]string, 0, 3)
+	if v.PlayerID != "" {
+		offenders = append(offenders, fmt.Sprintf("player %s", v.PlayerID))
+	}
+	if v.VertexID != 0 {
+		offenders = append(offenders, fmt.Sprintf("vertex#%d", v.VertexID))
+	}
+	if v.EdgeID != 0 {
+		offenders = append(offenders, fmt.Sprintf("edge#%d", v.EdgeID))
+	}
+	if len(offenders) == 0 {
+		return fmt.Sprintf("Invariant %s violated: %s", v.Invariant, v.Message)
+	}
+	return fmt.Sprintf("Invariant %s violated by %s: %s", v.Invariant, strings.Join(offenders, ", "), v.Message)
+}
+
+// SetStrictMode makes every mutating method run Validate once it's done.
+// Violations are returned by the method itself, so it's meant for tests and simulations
+func (state *GameState) SetStrictMode(strict bool) {
+	state.strict = strict
+}
+
+// Validate checks the state for inconsistencies between its parts and returns every violation found, joined
+func (state *GameState) Validate() error {
+	violations := make([]error, 0)
+	report := func(violation *InvariantViolation) {
+		violations = append(violations, violation)
+	}
+	state.validateResources(report)
+	state.validateBuildings(report)
+	state.validateDistanceRule(report)
+	state.validateRoadConnectivity(report)
+	state.validateDevelopmentCards(report)
+	state.validatePoints(report)
+	return errors.Join(violations...)
+}
+
+// Resources only enter hands from the bank, when drawn, picked for gold fields, handed out for exploring or traded,
+// and only leave them back to the bank, when used, discarded or traded.
+// Robberies, monopolies and player trades move resources between hands
+func (state *GameState) validateResources(report func(*InvariantViolation)) {
+	drawn := state.bookKeeping.GetResourcesDrawnByPlayer()
+	fromGold := state.bookKeeping.GetResourcesFromGoldByPlayer()
+	fromExploring := state.bookKeeping.GetResourcesFromExploringByPlayer()
+	used := state.bookKeeping.GetResourcesUsedByPlayer()
+	discarded := state.bookKeeping.GetResourcesDiscardedByPlayer()
+	givenToBank := state.bookKeeping.GetResourcesGivenToBankByPlayer()
+	receivedFromBank := state.bookKeeping.GetResourcesReceivedFromBankByPlayer()
+
+	var inHands, expected coreT.Resources
+	for _, player := range state.players {
+		hand := state.playersStates[player.ID].GetResources()
+		for _, resource := range ResourcesOrder {
+			if quantity := hand.Get(resource); quantity < 0 {
+				report(&InvariantViolation{
+					Invariant: InvariantResources,
+					PlayerID:  player.ID,
+					Message:   fmt.Sprintf("holds %d %s", quantity, resource),
+				})
+			}
+		}
+		inHands = inHands.Add(hand)
+		expected = expected.
+			Add(drawn[player.ID]).
+			Add(fromGold[player.ID]).
+			Add(fromExploring[player.ID]).
+			Subtract(used[player.ID]).
+			Subtract(discarded[player.ID]).
+			Add(receivedFromBank[player.ID]).
+			Subtract(givenToBank[player.ID])
+	}
+
+	supply := state.bank.GetSupply()
+	bank := state.bank.GetResources()
+	for _, resource := range ResourcesOrder {
+		if inHands.Get(resource) != expected.Get(resource) {
+			report(&InvariantViolation{
+				Invariant: InvariantResources,
+				Message:   fmt.Sprintf("hands hold %d %s, but bookkeeping accounts for %d", inHands.Get(resource), resource, expected.Get(resource)),
+			})
+		}
+		inBank := bank.Get(resource)
+		if inBank < 0 || inHands.Get(resource)+inBank != supply {
+			report(&InvariantViolation{
+				Invariant: InvariantResources,
+				Message:   fmt.Sprintf("hands hold %d %s and the bank %d, but the supply is %d", inHands.Get(resource), resource, inBank, supply),
+			})
+		}
+	}
+}
+
+// Board and players must agree on who owns each settlement, city, road and ship
+func (state *GameState) validateBuildings(report func(*InvariantViolation)) {
+	settlements := state.board.GetSettlements()
+	cities := state.board.GetCities()
+	roads := state.board.GetRoads()
+	ships := state.board.GetShips()
+
+	for _, vertexID := range slices.Sorted(maps.Keys(settlements)) {
+		if _, exists := cities[vertexID]; exists {
+			report(&InvariantViolation{
+				Invariant: InvariantBuildings,
+				VertexID:  vertexID,
+				Message:   "has both a settlement and a city on the board",
+			})
+		}
+	}
+
+	for _, edgeID := range slices.Sorted(maps.Keys(ships)) {
+		if _, exists := roads[edgeID]; exists {
+			report(&InvariantViolation{
+				Invariant: InvariantBuildings,
+				EdgeID:    edgeID,
+				Message:   "has both a road and a ship on the board",
+			})
+		}
+	}
+
+	compare := func(kind string, owned []int, onBoard map[int]string, playerID string, isEdge bool) {
+		numberOnBoard := 0
+		for _, owner := range onBoard {
+			if owner == playerID {
+				numberOnBoard++
+			}
+		}
+		if numberOnBoard != len(owned) {
+			report(&InvariantViolation{
+				Invariant: InvariantBuildings,
+				PlayerID:  playerID,
+				Message:   fmt.Sprintf("owns %d %s, but the board has %d", len(owned), kind, numberOnBoard),
+			})
+		}
+		for _, id := range owned {
+			owner, exists := onBoard[id]
+			if exists && owner == playerID {
+				continue
+			}
+			violation := &InvariantViolation{
+				Invariant: InvariantBuildings,
+				PlayerID:  playerID,
+				Message:   fmt.Sprintf("owns one of the %s, but the board says it belongs to '%s'", kind, owner),
+			}
+			if isEdge {
+				violation.EdgeID = id
+			} else {
+				violation.VertexID = id
+			}
+			report(violation)
+		}
+	}
+
+	settlementsOwners := make(map[int]string)
+	for vertexID, building := range settlements {
+		settlementsOwners[vertexID] = building.Owner
+	}
+	citiesOwners := make(map[int]string)
+	for vertexID, building := range cities {
+		citiesOwners[vertexID] = building.Owner
+	}
+	roadsOwners := make(map[int]string)
+	for edgeID, building := range roads {
+		roadsOwners[edgeID] = building.Owner
+	}
+	shipsOwners := make(map[int]string)
+	for edgeID, building := range ships {
+		shipsOwners[edgeID] = building.Owner
+	}
+
+	for _, player := range state.players {
+		playerState := state.playersStates[player.ID]
+		compare("settlements", playerState.GetSettlements(), settlementsOwners, player.ID, false)
+		compare("cities", playerState.GetCities(), citiesOwners, player.ID, false)
+		compare("roads", playerState.GetRoads(), roadsOwners, player.ID, true)
+		compare("ships", playerState.GetShips(), shipsOwners, player.ID, true)
+	}
+}
+
+// No two buildings may sit on adjacent vertices
+func (state *GameState) validateDistanceRule(report func(*InvariantViolation)) {
+	owners := make(map[int]string)
+	for vertexID, building := range state.board.GetSettlements() {
+		owners[vertexID] = building.Owner
+	}
+	for vertexID, building := range state.board.GetCities() {
+		owners[vertexID] = building.Owner
+	}
+
+	for _, vertexID := range slices.Sorted(maps.Keys(owners)) {
+		owner := owners[vertexID]
+		for _, edgeID := range state.board.Definition.EdgesByVertex[vertexID] {
+			for _, neighbourID := range state.board.Definition.VerticesByEdge[edgeID] {
+				if neighbourID == vertexID {
+					continue
+				}
+				// Reported once per pair
+				if neighbourOwner, exists := owners[neighbourID]; exists && vertexID < neighbourID {
+					report(&InvariantViolation{
+						Invariant: InvariantDistanceRule,
+						PlayerID:  owner,
+						VertexID:  vertexID,
+						Message:   fmt.Sprintf("is adjacent to vertex#%d owned by player %s", neighbourID, neighbourOwner),
+					})
+				}
+			}
+		}
+	}
+}
+
+// Every road and ship must be reachable from a settlement or city of its owner through roads and ships of the same owner
+func (state *GameState) validateRoadConnectivity(report func(*InvariantViolation)) {
+	for _, player := range state.players {
+		playerState := state.playersStates[player.ID]
+		reached := make(map[int]bool)
+		for _, vertexID := range playerState.GetSettlements() {
+			reached[vertexID] = true
+		}
+		for _, vertexID := range playerState.GetCities() {
+			reached[vertexID] = true
+		}
+
+		pending := append(slices.Clone(playerState.GetRoads()), playerState.GetShips()...)
+		for progress := true; progress; {
+			progress = false
+			remaining := pending[:0]
+			for _, edgeID := range pending {
+				vertices := state.board.Definition.VerticesByEdge[edgeID]
+				if reached[vertices[0]] || reached[vertices[1]] {
+					reached[vertices[0]] = true
+					reached[vertices[1]] = true
+					progress = true
+					continue
+				}
+				remaining = append(remaining, edgeID)
+			}
+			pending = remaining
+		}
+
+		for _, edgeID := range pending {
+			report(&InvariantViolation{
+				Invariant: InvariantRoadConnectivity,
+				PlayerID:  player.ID,
+				EdgeID:    edgeID,
+				Message:   "isn't connected to any settlement or city of its owner",
+			})
+		}
+	}
+}
+
+// Every development card is either in the deck, in a hand or used, and players hold what they drew
+func (state *GameState) validateDevelopmentCards(report func(*InvariantViolation)) {
+	drawnByPlayer := state.bookKeeping.GetDevCardsDrawnByPlayer()
+
+	outOfDeck := 0
+	for _, player := range state.players {
+		playerState := state.playersStates[player.ID]
+		held := make(map[string]int)
+		for name, cards := range playerState.GetDevelopmentCards() {
+			held[name] += len(cards)
+		}
+		for name, quantity := range playerState.GetUsedDevelopmentCards() {
+			held[name] += quantity
+		}
+
+		names := make([]string, 0)
+		for name := range held {
+			names = append(names, name)
+		}
+		for name := range drawnByPlayer[player.ID] {
+			if _, exists := held[name]; !exists {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			outOfDeck += held[name]
+			if held[name] != drawnByPlayer[player.ID][name] {
+				report(&InvariantViolation{
+					Invariant: InvariantDevelopmentCards,
+					PlayerID:  player.ID,
+					Message:   fmt.Sprintf("holds or used %d %s cards, but drew %d", held[name], name, drawnByPlayer[player.ID][name]),
+				})
+			}
+		}
+	}
+
+	remaining := state.development.Remaining()
+	size := state.development.Size()
+	if remaining+outOfDeck != size {
+		report(&InvariantViolation{
+			Invariant: InvariantDevelopmentCards,
+			Message:   fmt.Sprintf("%d cards in the deck and %d out of it, but the deck was made of %d", remaining, outOfDeck, size),
+		})
+	}
+}
+
+// Cached points must match the points recomputed from buildings, victory points and titles
+func (state *GameState) validatePoints(report func(*InvariantViolation)) {
+	for _, player := range state.players {
+		playerState := state.playersStates[player.ID]
+		points := state.pointsPerSettlement * len(playerState.GetSettlements())
+		points += state.pointsPerCity * len(playerState.GetCities())
+		points += playerState.GetNumberOfVictoryPoints()
+		if state.mostKnights.PlayerID == player.ID {
+			points += state.pointsPerMostKnights
+		}
+		if state.longestRoad.PlayerID == player.ID {
+			points += state.pointsPerLongestRoad
+		}
+		if state.points[player.ID] != points {
+			report(&InvariantViolation{
+				Invariant: InvariantPoints,
+				PlayerID:  player.ID,
+				Message:   fmt.Sprintf("has %d points cached, but should have %d", state.points[player.ID], points),
+			})
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+)
+
+func violations(err error) []*InvariantViolation {
+	found := make([]*InvariantViolation, 0)
+	if err == nil {
+		return found
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, inner := range joined.Unwrap() {
+			found = append(found, violations(inner)...)
+		}
+		return found
+	}
+	var violation *InvariantViolation
+	if errors.As(err, &violation) {
+		found = append(found, violation)
+	}
+	return found
+}
+
+func hasViolation(err error, invariant, playerID string) bool {
+	for _, violation := range violations(err) {
+		if violation.Invariant == invariant && violation.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// playRandomly applies random legal actions of any player
+func playRandomly(t testing.TB, game *GameState, seed int64, steps int) {
+	picker := rand.New(rand.NewSource(seed))
+	for step := 0; step < steps && game.RoundType() != round.GameOver; step++ {
+		allActions := make([]LegalAction, 0)
+		for _, player := range game.Players() {
+			allActions = append(allActions, game.LegalActions(player.ID)...)
+		}
+		if len(allActions) == 0 {
+			return
+		}
+		action := allActions[picker.Intn(len(allActions))]
+		err := game.ApplyCommand(concreteCommand(game, action))
+		if err != nil {
+			t.Fatalf("step %d: expected action %+v to be accepted, but actually got error %s", step, action.Command, err.Error())
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	game.SetStrictMode(true)
+	playRandomly(t, game, 42, 300)
+
+	if err := game.Validate(); err != nil {
+		t.Fatalf("expected game to be valid, but actually got error %s", err.Error())
+	}
+
+	t.Run("resources appearing out of nowhere", func(t *testing.T) {
+		clone := game.Clone()
+		clone.playersStates["1"].AddResource("Ore", 1)
+		err := clone.Validate()
+		if !hasViolation(err, InvariantResources, "") {
+			t.Errorf("expected resources violation, but actually got %v", err)
+		}
+	})
+
+	t.Run("negative resources", func(t *testing.T) {
+		clone := game.Clone()
+		clone.playersStates["2"].RemoveResource("Lumber", clone.playersStates["2"].GetResources().Lumber+1)
+		err := clone.Validate()
+		if !hasViolation(err, InvariantResources, "2") {
+			t.Errorf("expected resources violation by player 2, but actually got %v", err)
+		}
+	})
+
+	t.Run("building missing from player", func(t *testing.T) {
+		clone := game.Clone()
+		vertexID := 1
+		for clone.board.GetSettlements()[vertexID].Owner != "" || clone.board.GetCities()[vertexID].Owner != "" {
+			vertexID++
+		}
+		clone.board.AddSettlement("3", vertexID)
+		err := clone.Validate()
+		if !hasViolation(err, InvariantBuildings, "3") {
+			t.Errorf("expected buildings violation by player 3, but actually got %v", err)
+		}
+	})
+
+	t.Run("adjacent buildings", func(t *testing.T) {
+		clone := game.Clone()
+		vertexID := clone.playersStates["1"].GetSettlements()[0]
+		edgeID := clone.board.Definition.EdgesByVertex[vertexID][0]
+		neighbourID := clone.board.Definition.VerticesByEdge[edgeID][0]
+		if neighbourID == vertexID {
+			neighbourID = clone.board.Definition.VerticesByEdge[edgeID][1]
+		}
+		clone.board.AddSettlement("4", neighbourID)
+		clone.playersStates["4"].AddSettlement(neighbourID)
+		err := clone.Validate()
+		found := false
+		for _, violation := range violations(err) {
+			if violation.Invariant == InvariantDistanceRule && (violation.VertexID == vertexID || violation.VertexID == neighbourID) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected distance rule violation at vertex#%d, but actually got %v", vertexID, err)
+		}
+	})
+
+	t.Run("disconnected road", func(t *testing.T) {
+		clone := game.Clone()
+		touched := make(map[int]bool)
+		for _, vertexID := range append(clone.playersStates["1"].GetSettlements(), clone.playersStates["1"].GetCities()...) {
+			touched[vertexID] = true
+		}
+		for _, roadID := range clone.playersStates["1"].GetRoads() {
+			vertices := clone.board.Definition.VerticesByEdge[roadID]
+			touched[vertices[0]] = true
+			touched[vertices[1]] = true
+		}
+		edgeID := 0
+		for id := 1; edgeID == 0; id++ {
+			vertices := clone.board.Definition.VerticesByEdge[id]
+			if !touched[vertices[0]] && !touched[vertices[1]] {
+				edgeID = id
+			}
+		}
+		clone.board.AddRoad("1", edgeID)
+		clone.playersStates["1"].AddRoad(edgeID)
+		err := clone.Validate()
+		found := false
+		for _, violation := range violations(err) {
+			if violation.Invariant == InvariantRoadConnectivity && violation.EdgeID == edgeID {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected road connectivity violation at edge#%d, but actually got %v", edgeID, err)
+		}
+	})
+
+	t.Run("development card out of nowhere", func(t *testing.T) {
+		clone := game.Clone()
+		clone.playersStates["2"].AddDevelopmentCard(&coreT.DevelopmentCard{Name: "Knight"})
+		err := clone.Validate()
+		if !hasViolation(err, InvariantDevelopmentCards, "2") || !hasViolation(err, InvariantDevelopmentCards, "") {
+			t.Errorf("expected development cards violations, but actually got %v", err)
+		}
+	})
+
+	t.Run("stale points", func(t *testing.T) {
+		clone := game.Clone()
+		clone.points["3"]++
+		err := clone.Validate()
+		if !hasViolation(err, InvariantPoints, "3") {
+			t.Errorf("expected points violation by player 3, but actually got %v", err)
+		}
+	})
+}
+
+func TestStrictMode(t *testing.T) {
+	game := createSeededTestGame(t, 42)
+	playSetup(t, game)
+	game.playersStates["1"].AddResource("Ore", 1)
+
+	t.Run("disabled", func(t *testing.T) {
+		clone := game.Clone()
+		if err := clone.RollDice(clone.CurrentRoundPlayer().ID); err != nil {
+			t.Errorf("expected to roll dice just fine, but actually got error %s", err.Error())
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		clone := game.Clone()
+		clone.SetStrictMode(true)
+		err := clone.RollDice(clone.CurrentRoundPlayer().ID)
+		if !hasViolation(err, InvariantResources, "") {
+			t.Errorf("expected resources violation after rolling dice, but actually got %v", err)
+		}
+	})
+}
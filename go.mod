@@ -1,8 +1,6 @@
 module github.com/victoroliveirab/settlers
 
-go 1.23.0
-
-toolchain go1.23.6
+go 1.24.0
 
 require (
 	github.com/gorilla/websocket v1.5.3
@@ -2,11 +2,13 @@ package router
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gorilla/websocket"
+	mapsdefinitions "github.com/victoroliveirab/settlers/core/maps"
 	"github.com/victoroliveirab/settlers/db/models"
 	"github.com/victoroliveirab/settlers/logger"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
@@ -192,10 +194,21 @@ func SetupRoutes(db *sql.DB) {
 				return
 			}
 			id := r.FormValue("id")
+			mapName := r.FormValue("map")
+			if mapName == "" {
+				mapName = "base4"
+			}
+			meta, err := mapsdefinitions.GetMetadata(mapName)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Not supported map. Maps supported: %v", l.AvailableMaps()), http.StatusBadRequest)
+				return
+			}
 
-			room, err := l.CreateRoom(id, "base4", 4, 16)
+			boardCode := r.FormValue("boardCode")
+			room, err := l.CreateRoom(id, mapName, boardCode, meta.Players.Max, 16)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
 			}
 
 			room.RegisterIncomingMessageHandler(prematch.TryHandle)
@@ -210,6 +223,14 @@ func SetupRoutes(db *sql.DB) {
 		withLoggingMiddleware,
 	))
 
+	http.Handle("GET /maps", chainMiddleware(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(l.AvailableMaps())
+		}),
+		withSessionMiddleware(db),
+	))
+
 	// Client
 
 	http.HandleFunc("GET /login", func(w http.ResponseWriter, r *http.Request) {
@@ -10,13 +10,17 @@ import (
 
 func NewLobby() *Lobby {
 	return &Lobby{
-		availableRooms: []string{"base4"},
+		availableRooms: mapsdefinitions.AvailableMaps(),
 		rooms:          make(map[string]*Room),
 	}
 }
 
-// CreateRoom generates a new room and stores it in the Hub
-func (lobby *Lobby) CreateRoom(id, mapName string, capacity, randSeed int) (*Room, error) {
+func (lobby *Lobby) AvailableMaps() []string {
+	return lobby.availableRooms
+}
+
+// CreateRoom generates a new room and stores it in the Hub. With boardCode set, the match is played on that board
+func (lobby *Lobby) CreateRoom(id, mapName, boardCode string, capacity, randSeed int) (*Room, error) {
 	lobby.Lock()
 	defer lobby.Unlock()
 	_, exists := lobby.rooms[id]
@@ -35,6 +39,16 @@ func (lobby *Lobby) CreateRoom(id, mapName string, capacity, randSeed int) (*Roo
 		return nil, err
 	}
 
+	if boardCode != "" {
+		definitions, err := mapsdefinitions.GetMapDefinitions(mapName)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := mapsdefinitions.Decode(definitions, boardCode); err != nil {
+			return nil, err
+		}
+	}
+
 	paramsMeta := make(RoomParamsMeta)
 	paramsValues := make(map[string]int)
 	for key := range meta.Params {
@@ -58,6 +72,7 @@ func (lobby *Lobby) CreateRoom(id, mapName string, capacity, randSeed int) (*Roo
 	newRoom := NewRoom(id, mapName, capacity, randSeed, params, func(room *Room) {
 		lobby.RemoveRoom(room.ID)
 	})
+	newRoom.BoardCode = boardCode
 	lobby.rooms[id] = newRoom
 	return newRoom, nil
 }
@@ -25,6 +25,20 @@ func NewPlayer(user *models.User, room *Room, onDisconnect func(player *GamePlay
 	}
 }
 
+// NewBotPlayer creates a player that never connects, to sit in a bot seat
+func NewBotPlayer(id int64, username string, room *Room) *GamePlayer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &GamePlayer{
+		ID:           id,
+		Username:     username,
+		Color:        nil,
+		Room:         room,
+		OnDisconnect: func(player *GamePlayer) {},
+		ctx:          ctx,
+		cancelFunc:   cancel,
+	}
+}
+
 func (player *GamePlayer) Connect(
 	conn *websocket.Conn,
 	enqueueMessage func(msg *types.WebSocketClientRequest),
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/victoroliveirab/settlers/bot"
+	"github.com/victoroliveirab/settlers/core"
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/logger"
@@ -129,6 +131,60 @@ func (room *Room) AddPlayer(player *GamePlayer) error {
 	return err
 }
 
+// AddBot seats a bot of the given difficulty in the first empty seat. Only the room owner can add bots
+func (room *Room) AddBot(player *GamePlayer, difficulty bot.Difficulty) error {
+	if room.Owner != player.Username {
+		err := fmt.Errorf("cannot add bot to room %s: not room owner", room.ID)
+		return err
+	}
+	if !utils.SliceContains(bot.Difficulties, difficulty) {
+		err := fmt.Errorf("cannot add bot to room %s: unknown difficulty %s", room.ID, difficulty)
+		return err
+	}
+
+	room.Lock()
+	defer room.Unlock()
+
+	if room.Status != "prematch" {
+		err := fmt.Errorf("cannot add bot to room %s: match already started", room.ID)
+		return err
+	}
+
+	for i, spot := range room.Participants {
+		if spot.Player != nil {
+			continue
+		}
+		// Bots get negative IDs so they never clash with users
+		botPlayer := NewBotPlayer(int64(-(i + 1)), room.botUsername(i), room)
+		botPlayer.Color = &availableColors[i]
+		room.Participants[i] = RoomEntry{
+			Player:        botPlayer,
+			Ready:         true,
+			Bot:           true,
+			BotDifficulty: difficulty,
+		}
+		return nil
+	}
+
+	err := fmt.Errorf("cannot add bot to room %s: room full", room.ID)
+	return err
+}
+
+func (room *Room) botUsername(seat int) string {
+	username := fmt.Sprintf("Bot %d", seat+1)
+	for taken := true; taken; {
+		taken = false
+		for _, participant := range room.Participants {
+			if participant.Player != nil && participant.Player.Username == username {
+				taken = true
+				username += "'"
+				break
+			}
+		}
+	}
+	return username
+}
+
 func (room *Room) ChangePlayerColor(playerID int64, color string) error {
 	room.Lock()
 	defer room.Unlock()
@@ -300,7 +356,7 @@ func (room *Room) StartRound() error {
 		err := fmt.Errorf("Error: room#%s doesn't have a round manager initialized", room.ID)
 		return err
 	}
-	room.roundManager.start()
+	room.roundManager.start(room.isBotPhase(round.Regular))
 	return nil
 }
 
@@ -320,10 +376,41 @@ func (room *Room) StartSubRound(phase round.Type) error {
 		return err
 	}
 	room.roundManager.pause()
-	room.roundManager.startPhaseTimer(phase)
+	room.roundManager.startPhaseTimer(phase, room.isBotPhase(phase))
 	return nil
 }
 
+// isBotPhase tells whether only bots have to act during phase, so its timer can be shortened
+func (room *Room) isBotPhase(phase round.Type) bool {
+	if room.Game == nil {
+		return false
+	}
+	isBot := func(username string) bool {
+		for _, participant := range room.Participants {
+			if participant.Player != nil && participant.Player.Username == username {
+				return participant.Bot
+			}
+		}
+		return false
+	}
+	// Discards and gold picks are made by every player concerned, not only the current one
+	var amounts map[string]int
+	if phase == round.DiscardPhase {
+		amounts = room.Game.DiscardAmounts()
+	} else if phase == round.GoldPick {
+		amounts = room.Game.GoldPickAmounts()
+	}
+	if amounts != nil {
+		for playerID, amount := range amounts {
+			if amount > 0 && !isBot(playerID) {
+				return false
+			}
+		}
+		return true
+	}
+	return isBot(room.Game.CurrentRoundPlayer().ID)
+}
+
 func (room *Room) EndRound() error {
 	if room.roundManager == nil {
 		err := fmt.Errorf("Error: room#%s doesn't have a round manager initialized", room.ID)
@@ -504,6 +591,10 @@ func (room *Room) MinMax() [2]int {
 	return minMax
 }
 
+func (room *Room) Speed() int {
+	return room.params.Values["speed"]
+}
+
 func (room *Room) Params() []RoomParamsMetaEntry {
 	var entries []RoomParamsMetaEntry
 	for _, v := range room.params.Meta {
@@ -578,6 +669,20 @@ func (room *Room) UpdateParam(player *GamePlayer, key string, value int) error {
 	return nil
 }
 
+// SetScenario makes the match start from scenario instead of the setup phase. A nil scenario goes back to a regular match
+func (room *Room) SetScenario(player *GamePlayer, scenario *core.Scenario) error {
+	if room.Owner != player.Username {
+		err := fmt.Errorf("cannot set scenario in room %s: not room owner", room.ID)
+		return err
+	}
+	if room.Status != "prematch" {
+		err := fmt.Errorf("cannot set scenario in room %s: match already started", room.ID)
+		return err
+	}
+	room.Scenario = scenario
+	return nil
+}
+
 func (room *Room) TogglePlayerReadyState(playerID int64, newState bool) error {
 	room.Lock()
 	defer room.Unlock()
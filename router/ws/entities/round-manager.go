@@ -8,7 +8,7 @@ import (
 )
 
 // FIXME: temporary copy
-var roundTypeTranslation = [16]string{
+var roundTypeTranslation = [18]string{
 	"SettlementSetup#1",
 	"RoadSetup#1",
 	"SettlementSetup#2",
@@ -25,6 +25,8 @@ var roundTypeTranslation = [16]string{
 	"YearOfPlentyPickResources",
 	"DiscardPhase",
 	"GameOver",
+	"SpecialBuild",
+	"GoldPick",
 }
 
 var phaseDurationSpeed15 = map[round.Type]time.Duration{
@@ -43,6 +45,8 @@ var phaseDurationSpeed15 = map[round.Type]time.Duration{
 	round.MonopolyPickResource:      10 * time.Second,
 	round.YearOfPlentyPickResources: 10 * time.Second,
 	round.DiscardPhase:              10 * time.Second,
+	round.SpecialBuild:              10 * time.Second,
+	round.GoldPick:                  10 * time.Second,
 }
 
 var phaseDurationSpeed30 = map[round.Type]time.Duration{
@@ -61,6 +65,8 @@ var phaseDurationSpeed30 = map[round.Type]time.Duration{
 	round.MonopolyPickResource:      10 * time.Second,
 	round.YearOfPlentyPickResources: 10 * time.Second,
 	round.DiscardPhase:              10 * time.Second,
+	round.SpecialBuild:              10 * time.Second,
+	round.GoldPick:                  10 * time.Second,
 }
 
 var phaseDurationSpeed45 = map[round.Type]time.Duration{
@@ -79,6 +85,8 @@ var phaseDurationSpeed45 = map[round.Type]time.Duration{
 	round.MonopolyPickResource:      15 * time.Second,
 	round.YearOfPlentyPickResources: 15 * time.Second,
 	round.DiscardPhase:              15 * time.Second,
+	round.SpecialBuild:              15 * time.Second,
+	round.GoldPick:                  15 * time.Second,
 }
 
 var phaseDurationSpeed60 = map[round.Type]time.Duration{
@@ -97,6 +105,8 @@ var phaseDurationSpeed60 = map[round.Type]time.Duration{
 	round.MonopolyPickResource:      20 * time.Second,
 	round.YearOfPlentyPickResources: 20 * time.Second,
 	round.DiscardPhase:              20 * time.Second,
+	round.SpecialBuild:              20 * time.Second,
+	round.GoldPick:                  20 * time.Second,
 }
 
 var phaseDurationSpeed75 = map[round.Type]time.Duration{
@@ -115,6 +125,8 @@ var phaseDurationSpeed75 = map[round.Type]time.Duration{
 	round.MonopolyPickResource:      25 * time.Second,
 	round.YearOfPlentyPickResources: 25 * time.Second,
 	round.DiscardPhase:              25 * time.Second,
+	round.SpecialBuild:              25 * time.Second,
+	round.GoldPick:                  25 * time.Second,
 }
 
 var phaseDurationSpeed90 = map[round.Type]time.Duration{
@@ -133,6 +145,8 @@ var phaseDurationSpeed90 = map[round.Type]time.Duration{
 	round.MonopolyPickResource:      30 * time.Second,
 	round.YearOfPlentyPickResources: 30 * time.Second,
 	round.DiscardPhase:              30 * time.Second,
+	round.SpecialBuild:              30 * time.Second,
+	round.GoldPick:                  30 * time.Second,
 }
 
 var phaseDurationsBySpeed = map[int]map[round.Type]time.Duration{
@@ -157,12 +171,23 @@ func newRoundManager(speed int, onRegularTimeout func(), onExpireFuncs map[round
 	}
 }
 
-func (rm *roundManager) start() {
+// Bots act when their phase times out, so their phases only last long enough for players to follow along
+const botPhaseDuration = 2 * time.Second
+
+func (rm *roundManager) phaseDuration(phase round.Type, bot bool) time.Duration {
+	dur := phaseDurationsBySpeed[rm.speed][phase]
+	if bot && dur > botPhaseDuration {
+		return botPhaseDuration
+	}
+	return dur
+}
+
+func (rm *roundManager) start(bot bool) {
 	//logger.LogSystemMessage("room.roundManager.start", "start()")
 	rm.Lock()
 	defer rm.Unlock()
 
-	rm.remaining = phaseDurationsBySpeed[rm.speed][round.Regular]
+	rm.remaining = rm.phaseDuration(round.Regular, bot)
 	deadline := time.Now().UTC().Add(rm.remaining)
 	rm.deadline = &deadline
 	rm.subPhaseDeadline = nil
@@ -210,14 +235,14 @@ func (rm *roundManager) cancel() {
 	rm.subPhaseDeadline = nil
 }
 
-func (rm *roundManager) startPhaseTimer(phase round.Type) {
+func (rm *roundManager) startPhaseTimer(phase round.Type, bot bool) {
 	//logger.LogSystemMessage("room.roundManager.startPhaseTimer", fmt.Sprintf("phase = %s", roundTypeTranslation[phase]))
 	rm.Lock()
 	defer rm.Unlock()
 
 	rm.cancelSubTimer()
 
-	dur := phaseDurationsBySpeed[rm.speed][round.Type(phase)]
+	dur := rm.phaseDuration(phase, bot)
 
 	onExpire := rm.onExpireFuncs[phase]
 	rm.subTimer = time.AfterFunc(dur, onExpire)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/victoroliveirab/settlers/bot"
 	"github.com/victoroliveirab/settlers/core"
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	coreT "github.com/victoroliveirab/settlers/core/types"
@@ -23,6 +24,8 @@ type RoomEntry struct {
 	Player *GamePlayer `json:"player"`
 	Ready  bool        `json:"ready"`
 	Bot    bool        `json:"bot"`
+	// Empty for players who left mid match and are played by a normal bot
+	BotDifficulty bot.Difficulty `json:"botDifficulty,omitempty"`
 }
 
 type IncomingMessage struct {
@@ -60,6 +63,8 @@ type Room struct {
 	Capacity             int                          `json:"capacity"`
 	Game                 *core.GameState              `json:"-"`
 	MapName              string                       `json:"map"`
+	BoardCode            string                       `json:"boardCode,omitempty"`
+	Scenario             *core.Scenario               `json:"scenario,omitempty"`
 	params               RoomParams                   `json:"-"`
 	Participants         []RoomEntry                  `json:"participants"`
 	Private              bool                         `json:"private"`
@@ -0,0 +1,186 @@
+package match
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/victoroliveirab/settlers/bot"
+	"github.com/victoroliveirab/settlers/core"
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	"github.com/victoroliveirab/settlers/logger"
+	"github.com/victoroliveirab/settlers/router/ws/entities"
+	postmatch "github.com/victoroliveirab/settlers/router/ws/handlers/post-match"
+	wsUtils "github.com/victoroliveirab/settlers/router/ws/utils"
+)
+
+// How long the hard bot may think per decision, for every second of the room's speed
+const botBudgetPerSpeedUnit = 20 * time.Millisecond
+
+// Bound to the number of commands a bot issues in a single round, in case it gets stuck
+const maxBotCommandsPerRound = 50
+
+func isBotSeat(room *entities.Room, username string) bool {
+	for _, participant := range room.Participants {
+		if participant.Player != nil && participant.Player.Username == username {
+			return participant.Bot
+		}
+	}
+	return false
+}
+
+// botFor returns the bot playing on behalf of username: its seat's difficulty if it was seated as a bot,
+// a normal bot for players who left or let a phase time out
+func botFor(room *entities.Room, username string) bot.Bot {
+	difficulty := bot.Normal
+	for _, participant := range room.Participants {
+		if participant.Player != nil && participant.Player.Username == username && participant.BotDifficulty != "" {
+			difficulty = participant.BotDifficulty
+		}
+	}
+	budget := time.Duration(room.Speed()) * botBudgetPerSpeedUnit
+	b, err := bot.New(difficulty, budget, room.Rand.Int63())
+	if err != nil {
+		logger.LogSystemError(fmt.Sprintf("botFor.%s", room.ID), 1, err)
+		return bot.NewHeuristic()
+	}
+	return b
+}
+
+// timeoutCommand asks the bot of playerID for a command of commandType, falling back to the first legal one
+// if the bot would rather do something else
+func timeoutCommand(room *entities.Room, playerID string, commandType core.CommandType) (core.Command, error) {
+	command, ok := botFor(room, playerID).NextAction(room.Game, playerID)
+	if ok && command.Type == commandType {
+		return command, nil
+	}
+	for _, action := range room.Game.LegalActions(playerID) {
+		if action.Type == commandType {
+			return action.Command, nil
+		}
+	}
+	err := fmt.Errorf("No legal %s for player %s", commandType, playerID)
+	return core.Command{}, err
+}
+
+func botCommandLog(command core.Command) string {
+	switch command.Type {
+	case core.CommandBuildSettlement:
+		return fmt.Sprintf("%s built a new settlement.", command.PlayerID)
+	case core.CommandBuildCity:
+		return fmt.Sprintf("%s built a new city.", command.PlayerID)
+	case core.CommandBuildRoad, core.CommandPickRoadBuildingSpot:
+		return fmt.Sprintf("%s built a new road.", command.PlayerID)
+	case core.CommandBuyDevelopmentCard:
+		return fmt.Sprintf("%s bought a [dev q=1 v=?] card", command.PlayerID)
+	case core.CommandUseDevelopmentCard:
+		return fmt.Sprintf("%s used %s card", command.PlayerID, command.DevelopmentCard)
+	case core.CommandMoveRobber:
+		return fmt.Sprintf("%s moved robber.", command.PlayerID)
+	case core.CommandRobPlayer:
+		return fmt.Sprintf("%s robbed %s.", command.PlayerID, command.TargetPlayerID)
+	case core.CommandPickMonopolyResource:
+		return fmt.Sprintf("%s picked %s for monopoly.", command.PlayerID, command.Resource)
+	case core.CommandPickGoldResources:
+		return fmt.Sprintf("%s picked %s from gold.", command.PlayerID, wsUtils.FormatResources(command.Resources))
+	case core.CommandPickYearOfPlentyResources:
+		return fmt.Sprintf("%s picked %s and %s for year of plenty.", command.PlayerID, command.Resource, command.SecondResource)
+	case core.CommandMakeBankTrade, core.CommandMakeGeneralPortTrade, core.CommandMakeResourcePortTrade:
+		return fmt.Sprintf("%s traded %s for %s.", command.PlayerID, wsUtils.FormatResources(command.Given), wsUtils.FormatResources(command.Requested))
+	}
+	return fmt.Sprintf("%s played %s.", command.PlayerID, command.Type)
+}
+
+// playBotCommands lets the bot act for the current player until its next command would be stopAt.
+// Sub rounds started by its own dev cards are resolved right away, without waiting for their timeouts.
+// Returns false if the game ended meanwhile.
+func playBotCommands(room *entities.Room, stopAt core.CommandType) bool {
+	game := room.Game
+	playerID := game.CurrentRoundPlayer().ID
+	playerBot := botFor(room, playerID)
+
+	for i := 0; i < maxBotCommandsPerRound; i++ {
+		command, ok := playerBot.NextAction(game, playerID)
+		if !ok || command.Type == stopAt {
+			break
+		}
+		err := game.ApplyCommand(command)
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("playBotCommands.%s -> %s", room.ID, command.Type), 1, err)
+			break
+		}
+		if game.RoundType() == round.GameOver {
+			room.EndRound()
+			room.ProgressStatus()
+			room.EnqueueOutgoingMessage(postmatch.BuildPostMatchMessage(room), nil, nil)
+			return false
+		}
+		room.EnqueueBulkUpdate(
+			UpdateCurrentRoundPlayerState,
+			UpdateMapState,
+			UpdateEdgeState,
+			UpdateShipState,
+			UpdateVertexState,
+			UpdatePlayerHand,
+			UpdatePlayerDevHand,
+			UpdatePlayerDevHandPermissions,
+			UpdateResourceCount,
+			UpdateBank,
+			UpdateDevHandCount,
+			UpdatePortsState,
+			UpdateBuyDevelopmentCard,
+			UpdateKnightUsage,
+			UpdatePoints,
+			UpdateLongestRoadSize,
+			UpdateLogs([]string{botCommandLog(command)}),
+		)
+	}
+	return true
+}
+
+// playBotRound plays the rest of a regular round on behalf of the current player, then passes the turn
+func playBotRound(room *entities.Room) {
+	game := room.Game
+	playerID := game.CurrentRoundPlayer().ID
+	if !playBotCommands(room, core.CommandEndRound) {
+		return
+	}
+	if game.RoundType() != round.Regular {
+		// Bot got stuck in a sub round: let its timeout handle it
+		room.StartSubRound(game.RoundType())
+		return
+	}
+	game.EndRound(playerID)
+	handleEndRoundResponse(room, playerID)
+}
+
+// respondBotsToTrades makes every bot seat answer the trade offers waiting on them
+func respondBotsToTrades(room *entities.Room) {
+	game := room.Game
+	logs := make([]string, 0)
+	for _, participant := range room.Participants {
+		if participant.Player == nil || !participant.Bot {
+			continue
+		}
+		playerID := participant.Player.Username
+		command, ok := botFor(room, playerID).NextAction(game, playerID)
+		if !ok || (command.Type != core.CommandAcceptTradeOffer && command.Type != core.CommandRejectTradeOffer) {
+			continue
+		}
+		err := game.ApplyCommand(command)
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("respondBotsToTrades.%s -> %s", room.ID, command.Type), 1, err)
+			continue
+		}
+		if command.Type == core.CommandAcceptTradeOffer {
+			logs = append(logs, fmt.Sprintf("%s accepted the trade offer.", playerID))
+		} else {
+			logs = append(logs, fmt.Sprintf("%s rejected the trade offer.", playerID))
+		}
+	}
+	if len(logs) > 0 {
+		room.EnqueueBulkUpdate(
+			UpdateTradeOffers,
+			UpdateLogs(logs),
+		)
+	}
+}
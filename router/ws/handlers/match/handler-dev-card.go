@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	postmatch "github.com/victoroliveirab/settlers/router/ws/handlers/post-match"
 	"github.com/victoroliveirab/settlers/router/ws/types"
@@ -15,12 +16,12 @@ type devCardClickRequestPayload struct {
 }
 
 type monopolyPickRequestPayload struct {
-	Resource string `json:"resource"`
+	Resource coreT.Resource `json:"resource"`
 }
 
 type yearOfPlentyPickRequestPayload struct {
-	Resource1 string `json:"resource1"`
-	Resource2 string `json:"resource2"`
+	Resource1 coreT.Resource `json:"resource1"`
+	Resource2 coreT.Resource `json:"resource2"`
 }
 
 func handleBuyDevCard(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
@@ -36,6 +37,7 @@ func handleBuyDevCard(player *entities.GamePlayer, message *types.WebSocketClien
 	room.EnqueueBulkUpdate(
 		UpdateCurrentRoundPlayerState,
 		UpdateResourceCount,
+		UpdateBank,
 		UpdateDevHandCount,
 		UpdatePlayerHand,
 		UpdatePlayerDevHand,
@@ -98,6 +100,7 @@ func handleDevCardClick(player *entities.GamePlayer, message *types.WebSocketCli
 			UpdateCurrentRoundPlayerState,
 			UpdateDevHandCount,
 			UpdateEdgeState,
+			UpdateShipState,
 			UpdatePlayerDevHand,
 			UpdatePlayerDevHandPermissions,
 			UpdatePass,
@@ -152,7 +155,7 @@ func handleMonopolyResource(player *entities.GamePlayer, message *types.WebSocke
 	return true, nil
 }
 
-func handleMonopolyResourceResponse(room *entities.Room, resourceStolen string, resourceCountBefore int) {
+func handleMonopolyResourceResponse(room *entities.Room, resourceStolen coreT.Resource, resourceCountBefore int) {
 	game := room.Game
 	currentRoundPlayer := game.CurrentRoundPlayer().ID
 
@@ -194,7 +197,7 @@ func handlePickYearOfPlentyResources(player *entities.GamePlayer, message *types
 	return true, nil
 }
 
-func handlePickYearOfPlentyResourcesResponse(room *entities.Room, resource1, resource2 string) {
+func handlePickYearOfPlentyResourcesResponse(room *entities.Room, resource1, resource2 coreT.Resource) {
 	game := room.Game
 	currentRoundPlayer := game.CurrentRoundPlayer().ID
 
@@ -203,6 +206,7 @@ func handlePickYearOfPlentyResourcesResponse(room *entities.Room, resource1, res
 	room.EnqueueBulkUpdate(
 		UpdateCurrentRoundPlayerState,
 		UpdateResourceCount,
+		UpdateBank,
 		UpdatePlayerHand,
 		UpdateYOP,
 		UpdateLogs(logs),
@@ -2,10 +2,9 @@ package match
 
 import (
 	"fmt"
-	"maps"
 
+	"github.com/victoroliveirab/settlers/core"
 	"github.com/victoroliveirab/settlers/core/packages/round"
-	"github.com/victoroliveirab/settlers/logger"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	"github.com/victoroliveirab/settlers/router/ws/types"
 )
@@ -14,21 +13,18 @@ func handleDiceRoll(player *entities.GamePlayer, message *types.WebSocketClientR
 	room := player.Room
 	game := room.Game
 
-	prevResourceHands := map[string]map[string]int{}
-	for _, player := range game.Players() {
-		prevResourceHands[player.ID] = maps.Clone(game.ResourceHandByPlayer(player.ID))
-	}
-
+	stopWatching := watchProduction(game)
 	err := game.RollDice(player.Username)
+	produced := stopWatching()
 	if err != nil {
 		wsErr := player.WriteJsonError(message.Type, err)
 		return true, wsErr
 	}
-	handleDiceRollResponse(room, prevResourceHands)
+	handleDiceRollResponse(room, produced)
 	return true, nil
 }
 
-func handleDiceRollResponse(room *entities.Room, prevResourceHands map[string]map[string]int) {
+func handleDiceRollResponse(room *entities.Room, produced []core.ResourcesProduced) {
 	game := room.Game
 	currentRoundPlayer := game.CurrentRoundPlayer().ID
 	dices := game.Dice()
@@ -37,16 +33,12 @@ func handleDiceRollResponse(room *entities.Room, prevResourceHands map[string]ma
 
 	logs := make([]string, 1)
 	logs[0] = fmt.Sprintf("%s rolled [dice v=%d][dice v=%d]", currentRoundPlayer, dice1, dice2)
+	if secondDice := game.SecondDice(); secondDice[0] > 0 {
+		logs = append(logs, fmt.Sprintf("%s rolled again [dice v=%d][dice v=%d]", currentRoundPlayer, secondDice[0], secondDice[1]))
+	}
 
-	for _, player := range game.Players() {
-		diff, err := diffResourceHands(prevResourceHands[player.ID], game.ResourceHandByPlayer(player.ID))
-		if err != nil {
-			logger.LogError(-1, "handleDiceRoll.diffResourceHands", -1, err)
-			continue
-		}
-		if hasDiff(diff) {
-			logs = append(logs, fmt.Sprintf("%s got %s", player.ID, formatResourceCollection(diff)))
-		}
+	for _, event := range produced {
+		logs = append(logs, fmt.Sprintf("%s got %s", event.PlayerID, formatResourceCollection(event.Resources)))
 	}
 
 	if game.RoundType() == round.MoveRobberDue7 {
@@ -60,6 +52,26 @@ func handleDiceRollResponse(room *entities.Room, prevResourceHands map[string]ma
 			UpdateTrade,
 			UpdateVertexState,
 			UpdateEdgeState,
+			UpdateShipState,
+			UpdateBuyDevelopmentCard,
+			UpdatePlayerDevHandPermissions,
+			UpdateLogs(logs),
+		)
+	} else if game.RoundType() == round.GoldPick {
+		logs = append(logs, fmt.Sprintf("some players have to pick gold resources"))
+		room.StartSubRound(round.GoldPick)
+		room.EnqueueBulkUpdate(
+			UpdateCurrentRoundPlayerState,
+			UpdateDiceState,
+			UpdatePlayerHand,
+			UpdateResourceCount,
+			UpdateBank,
+			UpdateGoldPick,
+			UpdatePass,
+			UpdateTrade,
+			UpdateVertexState,
+			UpdateEdgeState,
+			UpdateShipState,
 			UpdateBuyDevelopmentCard,
 			UpdatePlayerDevHandPermissions,
 			UpdateLogs(logs),
@@ -75,6 +87,7 @@ func handleDiceRollResponse(room *entities.Room, prevResourceHands map[string]ma
 			UpdateTrade,
 			UpdateVertexState,
 			UpdateEdgeState,
+			UpdateShipState,
 			UpdateBuyDevelopmentCard,
 			UpdatePlayerDevHandPermissions,
 			UpdateLogs(logs),
@@ -86,10 +99,12 @@ func handleDiceRollResponse(room *entities.Room, prevResourceHands map[string]ma
 			UpdateDiceState,
 			UpdatePlayerHand,
 			UpdateResourceCount,
+			UpdateBank,
 			UpdatePass,
 			UpdateTrade,
 			UpdateVertexState,
 			UpdateEdgeState,
+			UpdateShipState,
 			UpdateBuyDevelopmentCard,
 			UpdatePlayerDevHandPermissions,
 			UpdateLogs(logs),
@@ -4,13 +4,14 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	"github.com/victoroliveirab/settlers/router/ws/types"
 	"github.com/victoroliveirab/settlers/router/ws/utils"
 )
 
 type discardCardsRequestPayload struct {
-	Resources map[string]int `json:"resources"`
+	Resources coreT.Resources `json:"resources"`
 }
 
 func handleDiscardCards(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
@@ -44,6 +45,7 @@ func handleDiscardCardsResponse(room *entities.Room, logs []string) {
 			UpdateCurrentRoundPlayerState,
 			UpdatePlayerHand,
 			UpdateResourceCount,
+			UpdateBank,
 			UpdateDiscardPhase,
 			UpdateRobberMovement,
 			UpdateLogs(logs),
@@ -54,6 +56,7 @@ func handleDiscardCardsResponse(room *entities.Room, logs []string) {
 			UpdateCurrentRoundPlayerState,
 			UpdatePlayerHand,
 			UpdateResourceCount,
+			UpdateBank,
 			UpdateDiscardPhase,
 			UpdateLogs(logs),
 		)
@@ -52,8 +52,10 @@ func handleEdgeClickSetupResponse(room *entities.Room, logs []string) {
 			UpdateMapState,
 			UpdateVertexState,
 			UpdateEdgeState,
+			UpdateShipState,
 			UpdatePlayerHand,
 			UpdateResourceCount,
+			UpdateBank,
 			UpdateDiceState,
 			UpdateBuyDevelopmentCard,
 			UpdateLongestRoadSize,
@@ -68,6 +70,7 @@ func handleEdgeClickSetupResponse(room *entities.Room, logs []string) {
 			UpdateMapState,
 			UpdateVertexState,
 			UpdateEdgeState,
+			UpdateShipState,
 			UpdatePlayerHand,
 			UpdateBuyDevelopmentCard,
 			UpdateLongestRoadSize,
@@ -89,7 +92,9 @@ func handleEdgeClickMatchResponse(room *entities.Room, prevRoundType round.Type,
 		UpdateMapState,
 		UpdateVertexState,
 		UpdateEdgeState,
+		UpdateShipState,
 		UpdatePlayerHand,
+		UpdateBank,
 		UpdateBuyDevelopmentCard,
 		UpdateLongestRoadSize,
 		UpdatePoints,
@@ -0,0 +1,94 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/victoroliveirab/settlers/core/packages/round"
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/router/ws/entities"
+	"github.com/victoroliveirab/settlers/router/ws/types"
+	"github.com/victoroliveirab/settlers/router/ws/utils"
+)
+
+type pickGoldRequestPayload struct {
+	Resources coreT.Resources `json:"resources"`
+}
+
+func handlePickGold(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
+	payload, err := utils.ParseJsonPayload[pickGoldRequestPayload](message)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	room := player.Room
+	game := room.Game
+	err = game.PickGoldResources(player.Username, payload.Resources)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	formattedResources := utils.FormatResources(payload.Resources)
+	logs := []string{fmt.Sprintf("%s picked %s from gold", player.Username, formattedResources)}
+	handlePickGoldResponse(room, logs)
+	return true, nil
+}
+
+func handlePickGoldResponse(room *entities.Room, logs []string) {
+	game := room.Game
+
+	switch game.RoundType() {
+	case round.GoldPick:
+		// there are still players that need to pick
+		room.EnqueueBulkUpdate(
+			UpdatePlayerHand,
+			UpdateResourceCount,
+			UpdateBank,
+			UpdateGoldPick,
+			UpdateLogs(logs),
+		)
+	case round.DiscardPhase:
+		logs = append(logs, "some players have to discard")
+		room.StartSubRound(round.DiscardPhase)
+		room.EnqueueBulkUpdate(
+			UpdateCurrentRoundPlayerState,
+			UpdatePlayerHand,
+			UpdateResourceCount,
+			UpdateBank,
+			UpdateGoldPick,
+			UpdateDiscardPhase,
+			UpdateLogs(logs),
+		)
+	case round.MoveRobberDue7:
+		logs = append(logs, fmt.Sprintf("%s moving robber", game.CurrentRoundPlayer().ID))
+		room.StartSubRound(round.MoveRobberDue7)
+		room.EnqueueBulkUpdate(
+			UpdateCurrentRoundPlayerState,
+			UpdatePlayerHand,
+			UpdateResourceCount,
+			UpdateBank,
+			UpdateGoldPick,
+			UpdateRobberMovement,
+			UpdateLogs(logs),
+		)
+	default:
+		// last player that needed to pick has picked
+		room.ResumeRound()
+		room.EnqueueBulkUpdate(
+			UpdateCurrentRoundPlayerState,
+			UpdatePlayerHand,
+			UpdateResourceCount,
+			UpdateBank,
+			UpdateGoldPick,
+			UpdatePass,
+			UpdateTrade,
+			UpdateVertexState,
+			UpdateEdgeState,
+			UpdateShipState,
+			UpdateBuyDevelopmentCard,
+			UpdatePlayerDevHandPermissions,
+			UpdateLogs(logs),
+		)
+	}
+}
@@ -22,9 +22,15 @@ func handleEndRound(player *entities.GamePlayer, message *types.WebSocketClientR
 }
 
 func handleEndRoundResponse(room *entities.Room, player string) {
+	logs := []string{fmt.Sprintf("%s finished their round.", player)}
+	if room.Game.RoundType() == round.SpecialBuild {
+		logs = append(logs, fmt.Sprintf("%s may build now.", room.Game.CurrentRoundPlayer().ID))
+	}
+
 	room.EndRound()
 	room.StartRound()
-	room.StartSubRound(round.BetweenTurns)
+	// Either the next player rolls or, with the special building phase, the next player builds
+	room.StartSubRound(room.Game.RoundType())
 	room.EnqueueBulkUpdate(
 		UpdateCurrentRoundPlayerState,
 		UpdateDiceState,
@@ -32,6 +38,6 @@ func handleEndRoundResponse(room *entities.Room, player string) {
 		UpdateTrade,
 		UpdateBuyDevelopmentCard,
 		UpdatePlayerDevHandPermissions,
-		UpdateLogs([]string{fmt.Sprintf("%s finished their round.", player)}),
+		UpdateLogs(logs),
 	)
 }
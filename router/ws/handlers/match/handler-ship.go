@@ -0,0 +1,72 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/victoroliveirab/settlers/router/ws/entities"
+	"github.com/victoroliveirab/settlers/router/ws/types"
+	"github.com/victoroliveirab/settlers/router/ws/utils"
+)
+
+type moveShipRequestPayload struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+func handleShipClick(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
+	payload, err := utils.ParseJsonPayload[edgeClickRequestPayload](message)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	room := player.Room
+	game := room.Game
+	err = game.BuildShip(player.Username, payload.EdgeID)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	logs := []string{fmt.Sprintf("%s has built a new ship.", player.Username)}
+	room.EnqueueBulkUpdate(
+		UpdateMapState,
+		UpdateVertexState,
+		UpdateEdgeState,
+		UpdateShipState,
+		UpdatePlayerHand,
+		UpdateBank,
+		UpdateBuyDevelopmentCard,
+		UpdateLongestRoadSize,
+		UpdatePoints,
+		UpdateLogs(logs),
+	)
+	return true, nil
+}
+
+func handleMoveShip(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
+	payload, err := utils.ParseJsonPayload[moveShipRequestPayload](message)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	room := player.Room
+	game := room.Game
+	err = game.MoveShip(player.Username, payload.From, payload.To)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	logs := []string{fmt.Sprintf("%s has moved a ship.", player.Username)}
+	room.EnqueueBulkUpdate(
+		UpdateMapState,
+		UpdateVertexState,
+		UpdateShipState,
+		UpdateLongestRoadSize,
+		UpdatePoints,
+		UpdateLogs(logs),
+	)
+	return true, nil
+}
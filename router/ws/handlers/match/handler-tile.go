@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/victoroliveirab/settlers/core/packages/round"
+	"github.com/victoroliveirab/settlers/logger"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	"github.com/victoroliveirab/settlers/router/ws/types"
 	"github.com/victoroliveirab/settlers/router/ws/utils"
@@ -45,7 +46,11 @@ func handleMoveRobberResponse(room *entities.Room) {
 	logs := make([]string, 0)
 	logs = append(logs, fmt.Sprintf("%s moved the robber.", currentRoundPlayer))
 	if roundType == round.Regular || roundType == round.BetweenTurns {
-		logs = append(logs, fmt.Sprintf("No player to rob."))
+		if game.IsRobbingSuspended() {
+			logs = append(logs, fmt.Sprintf("Robbing is suspended until round %d.", game.RobberProtectionRounds()+1))
+		} else {
+			logs = append(logs, fmt.Sprintf("No player to rob."))
+		}
 		if roundType == round.Regular {
 			room.ResumeRound()
 		} else {
@@ -53,13 +58,19 @@ func handleMoveRobberResponse(room *entities.Room) {
 			room.StartSubRound(round.BetweenTurns)
 		}
 	} else if len(robbablePlayers) == 1 {
-		game.RobPlayer(currentRoundPlayer, robbablePlayers[0])
-		logs = append(logs, fmt.Sprintf("%s robbed %s.", currentRoundPlayer, robbablePlayers[0]))
-		if roundType == round.Regular {
-			room.ResumeRound()
+		err := game.RobPlayer(currentRoundPlayer, robbablePlayers[0])
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("handleMoveRobberResponse.%s -> game.RobPlayer(%s)", room.ID, robbablePlayers[0]), 1, err)
+			logs = append(logs, fmt.Sprintf("%s choosing who to rob.", currentRoundPlayer))
+			room.StartSubRound(roundType)
 		} else {
-			// NOTE: this resets the counter instead of keeping in running, but I guess it's fine
-			room.StartSubRound(round.BetweenTurns)
+			logs = append(logs, fmt.Sprintf("%s robbed %s.", currentRoundPlayer, robbablePlayers[0]))
+			if roundType == round.Regular {
+				room.ResumeRound()
+			} else {
+				// NOTE: this resets the counter instead of keeping in running, but I guess it's fine
+				room.StartSubRound(round.BetweenTurns)
+			}
 		}
 	} else {
 		logs = append(logs, fmt.Sprintf("%s choosing who to rob.", currentRoundPlayer))
@@ -77,6 +88,7 @@ func handleMoveRobberResponse(room *entities.Room) {
 		UpdatePass,
 		UpdateTrade,
 		UpdateEdgeState,
+		UpdateShipState,
 		UpdateVertexState,
 		UpdateLogs(logs),
 	)
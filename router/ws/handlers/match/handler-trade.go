@@ -3,35 +3,36 @@ package match
 import (
 	"fmt"
 
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	"github.com/victoroliveirab/settlers/router/ws/types"
 	"github.com/victoroliveirab/settlers/router/ws/utils"
 )
 
 type makeBankTradeRequestPayload struct {
-	Given     map[string]int `json:"given"`
-	Requested map[string]int `json:"requested"`
+	Given     coreT.Resources `json:"given"`
+	Requested coreT.Resources `json:"requested"`
 }
 
 type makeGeneralPortTradeRequestPayload struct {
-	Given     map[string]int `json:"given"`
-	Requested map[string]int `json:"requested"`
+	Given     coreT.Resources `json:"given"`
+	Requested coreT.Resources `json:"requested"`
 }
 
 type makeResourcePortTradeRequestPayload struct {
-	Given     map[string]int `json:"given"`
-	Requested map[string]int `json:"requested"`
+	Given     coreT.Resources `json:"given"`
+	Requested coreT.Resources `json:"requested"`
 }
 
 type createTradeOfferRequestPayload struct {
-	Given     map[string]int `json:"given"`
-	Requested map[string]int `json:"requested"`
+	Given     coreT.Resources `json:"given"`
+	Requested coreT.Resources `json:"requested"`
 }
 
 type createCounterTradeOfferRequestPayload struct {
-	Given     map[string]int `json:"given"`
-	Requested map[string]int `json:"requested"`
-	TradeID   int            `json:"tradeID"`
+	Given     coreT.Resources `json:"given"`
+	Requested coreT.Resources `json:"requested"`
+	TradeID   int             `json:"tradeID"`
 }
 
 type acceptTradeOfferRequestPayload struct {
@@ -75,6 +76,7 @@ func handleMakeBankTrade(player *entities.GamePlayer, message *types.WebSocketCl
 	logs := []string{fmt.Sprintf("%s traded %s for %s with the bank", player.Username, formattedResourceGiven, formattedResourceRequested)}
 	room.EnqueueBulkUpdate(
 		UpdateResourceCount,
+		UpdateBank,
 		UpdatePlayerHand,
 		UpdateLogs(logs),
 	)
@@ -105,6 +107,7 @@ func handleMakeGeneralPortTrade(player *entities.GamePlayer, message *types.WebS
 	logs := []string{fmt.Sprintf("%s traded %s for %s with the port", player.Username, formattedResourceGiven, formattedResourceRequested)}
 	room.EnqueueBulkUpdate(
 		UpdateResourceCount,
+		UpdateBank,
 		UpdatePlayerHand,
 		UpdateLogs(logs),
 	)
@@ -136,6 +139,7 @@ func handleMakeResourcePortTrade(player *entities.GamePlayer, message *types.Web
 	logs := []string{fmt.Sprintf("%s traded %s for %s with the port", player.Username, formattedResourceGiven, formattedResourceRequested)}
 	room.EnqueueBulkUpdate(
 		UpdateResourceCount,
+		UpdateBank,
 		UpdatePlayerHand,
 		UpdateLogs(logs),
 	)
@@ -169,6 +173,7 @@ func handleCreateTradeOffer(player *entities.GamePlayer, message *types.WebSocke
 		UpdateTradeOffers,
 		UpdateLogs(logs),
 	)
+	respondBotsToTrades(room)
 
 	return true, nil
 }
@@ -195,6 +200,7 @@ func handleCreateCounterTradeOffer(player *entities.GamePlayer, message *types.W
 	room.EnqueueBulkUpdate(
 		UpdateTradeOffers,
 	)
+	respondBotsToTrades(room)
 
 	return true, nil
 }
@@ -0,0 +1,63 @@
+package match
+
+import (
+	"fmt"
+
+	coreT "github.com/victoroliveirab/settlers/core/types"
+	"github.com/victoroliveirab/settlers/router/ws/entities"
+	"github.com/victoroliveirab/settlers/router/ws/types"
+	"github.com/victoroliveirab/settlers/router/ws/utils"
+)
+
+type forceTradeRequestPayload struct {
+	Given  coreT.Resources `json:"given"`
+	Player string          `json:"player"`
+}
+
+func handleForceTrade(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
+	payload, err := utils.ParseJsonPayload[forceTradeRequestPayload](message)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	room := player.Room
+	game := room.Game
+	err = game.ForceTrade(player.Username, payload.Player, payload.Given)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	logs := []string{fmt.Sprintf("%s forced a trade with %s, giving %s", player.Username, payload.Player, formatResourceCollection(payload.Given))}
+	room.EnqueueBulkUpdate(
+		UpdateResourceCount,
+		UpdatePlayerHand,
+		UpdateTrade,
+		UpdateTradeTokens,
+		UpdateVertexState,
+		UpdateEdgeState,
+		UpdateShipState,
+		UpdateBuyDevelopmentCard,
+		UpdateLogs(logs),
+	)
+	return true, nil
+}
+
+func handleMoveRobberToDesert(player *entities.GamePlayer, message *types.WebSocketClientRequest) (bool, error) {
+	room := player.Room
+	game := room.Game
+	err := game.MoveRobberToDesert(player.Username)
+	if err != nil {
+		wsErr := player.WriteJsonError(message.Type, err)
+		return true, wsErr
+	}
+
+	logs := []string{fmt.Sprintf("%s sent the robber back to the desert", player.Username)}
+	room.EnqueueBulkUpdate(
+		UpdateMapState,
+		UpdateTradeTokens,
+		UpdateLogs(logs),
+	)
+	return true, nil
+}
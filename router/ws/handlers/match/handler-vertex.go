@@ -46,13 +46,16 @@ func handleVertexClick(player *entities.GamePlayer, message *types.WebSocketClie
 		UpdateCurrentRoundPlayerState,
 		UpdateMapState,
 		UpdateEdgeState,
+		UpdateShipState,
 		UpdateVertexState,
 		UpdatePlayerHand,
+		UpdateBank,
 		UpdatePortsState,
 		UpdateBuyDevelopmentCard,
 		UpdatePoints,
 		UpdatePortsState,
 		UpdateLongestRoadSize,
+		UpdateTradeTokens,
 		UpdateLogs(logs),
 	)
 	return true, nil
@@ -1,34 +1,26 @@
 package match
 
 import (
-	"fmt"
-
+	"github.com/victoroliveirab/settlers/core"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/router/ws/utils"
 )
 
-func diffResourceHands(before, after map[string]int) (map[string]int, error) {
-	diff := map[string]int{}
-	for resource, afterQuantity := range after {
-		beforeQuantity, exists := before[resource]
-		if !exists {
-			return nil, fmt.Errorf("cannot compare two different shapes of hands - mismatch in key %s", resource)
-		}
-		diff[resource] = afterQuantity - beforeQuantity
-	}
-
-	return diff, nil
-}
-
-func hasDiff(diff map[string]int) bool {
-	for _, quantity := range diff {
-		if quantity != 0 {
-			return true
+// watchProduction collects the resources produced in game until the returned function is called
+func watchProduction(game *core.GameState) func() []core.ResourcesProduced {
+	produced := make([]core.ResourcesProduced, 0)
+	unsubscribe := game.Subscribe(func(event core.Event) {
+		if event, ok := event.(core.ResourcesProduced); ok {
+			produced = append(produced, event)
 		}
+	})
+	return func() []core.ResourcesProduced {
+		unsubscribe()
+		return produced
 	}
-	return false
 }
 
 // TODO: get rid of this and use the utils fn directly
-func formatResourceCollection(collection map[string]int) string {
+func formatResourceCollection(collection coreT.Resources) string {
 	return utils.FormatResources(collection)
 }
@@ -13,6 +13,10 @@ func TryHandle(player *entities.GamePlayer, message *types.WebSocketClientReques
 		return handleVertexClick(player, message)
 	case "match.edge-click":
 		return handleEdgeClick(player, message)
+	case "match.ship-click":
+		return handleShipClick(player, message)
+	case "match.move-ship":
+		return handleMoveShip(player, message)
 	case "match.tile-click":
 		return handleTileClick(player, message)
 	case "match.pass-click":
@@ -43,10 +47,16 @@ func TryHandle(player *entities.GamePlayer, message *types.WebSocketClientReques
 		return handlePickRobbedPlayer(player, message)
 	case "match.discard-cards":
 		return handleDiscardCards(player, message)
+	case "match.pick-gold":
+		return handlePickGold(player, message)
 	case "match.monopoly":
 		return handleMonopolyResource(player, message)
 	case "match.year-of-plenty":
 		return handlePickYearOfPlentyResources(player, message)
+	case "match.force-trade":
+		return handleForceTrade(player, message)
+	case "match.robber-to-desert":
+		return handleMoveRobberToDesert(player, message)
 	case "match.end-round":
 		return handleEndRound(player, message)
 	case "match.report":
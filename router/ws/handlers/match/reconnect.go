@@ -18,16 +18,19 @@ func SendCurrentGameState(player *entities.GamePlayer) error {
 		hydrateMsg := &types.WebSocketServerResponse{
 			Type: "setup.hydrate",
 			Payload: hydrateSetupMatchResponsePayload{
+				Bank:              game.BankResources(),
 				DevHandCount:      game.NumberOfDevCardsByPlayer(),
 				EdgeUpdate:        edgeState,
 				Map:               game.GetBoard(),
 				MapName:           game.MapName(),
 				MapUpdate:         mapState,
+				NeutralPlayers:    game.NeutralPlayers(),
 				Players:           game.Players(),
 				Ports:             game.Ports(),
 				ResourceCount:     game.NumberOfResourcesByPlayer(),
 				RoomStatus:        room.Status,
 				RoundPlayerUpdate: currentRoundState,
+				SeaTiles:          game.GetSeaTiles(),
 				VertexUpdate:      vertexState,
 			},
 		}
@@ -49,6 +52,7 @@ func SendCurrentGameState(player *entities.GamePlayer) error {
 	devHandState := UpdatePlayerDevHand(room, player.Username)
 	devHandPermissionsState := UpdatePlayerDevHandPermissions(room, player.Username)
 	discardPhaseState := UpdateDiscardPhase(room, player.Username)
+	goldPickState := UpdateGoldPick(room, player.Username)
 	passState := UpdatePass(room, player.Username)
 	tradeState := UpdateTrade(room, player.Username)
 	tradeOffersState := UpdateTradeOffers(room, player.Username)
@@ -56,16 +60,20 @@ func SendCurrentGameState(player *entities.GamePlayer) error {
 	robbablePlayersState := UpdateRobbablePlayers(room, player.Username)
 	buyDevCardState := UpdateBuyDevelopmentCard(room, player.Username)
 	yearOfPlentyState := UpdateYOP(room, player.Username)
+	tradeTokensState := UpdateTradeTokens(room, player.Username)
+	shipState := UpdateShipState(room, player.Username)
 
 	hydrateMsg := &types.WebSocketServerResponse{
 		Type: "match.hydrate",
 		Payload: hydrateOngoingMatchResponsePayload{
+			Bank:                     game.BankResources(),
 			BuyDevCardUpdate:         buyDevCardState,
 			DevHandCount:             game.NumberOfDevCardsByPlayer(),
 			DevHandUpdate:            devHandState,
 			DevHandPermissionsUpdate: devHandPermissionsState,
 			DiceUpdate:               diceState,
 			DiscardUpdate:            discardPhaseState,
+			GoldPickUpdate:           goldPickState,
 			EdgeUpdate:               edgeState,
 			HandUpdate:               handState,
 			KnightsUsageUpdate:       knightsUsageState,
@@ -73,6 +81,7 @@ func SendCurrentGameState(player *entities.GamePlayer) error {
 			Map:                      game.GetBoard(),
 			MapName:                  game.MapName(),
 			MapUpdate:                mapState,
+			NeutralPlayers:           game.NeutralPlayers(),
 			PassActionState:          passState,
 			Players:                  game.Players(),
 			PointsUpdate:             pointsState,
@@ -83,8 +92,11 @@ func SendCurrentGameState(player *entities.GamePlayer) error {
 			RobberUpdate:             robberMovementState,
 			RoomStatus:               room.Status,
 			RoundPlayerUpdate:        currentRoundState,
+			SeaTiles:                 game.GetSeaTiles(),
+			ShipUpdate:               shipState,
 			TradeActionState:         tradeState,
 			TradeOffersUpdate:        tradeOffersState,
+			TradeTokensUpdate:        tradeTokensState,
 			VertexUpdate:             vertexState,
 			YearOfPlentyUpdate:       yearOfPlentyState,
 		},
@@ -2,15 +2,13 @@ package match
 
 import (
 	"fmt"
-	"maps"
-	"math/rand"
 	"time"
 
+	"github.com/victoroliveirab/settlers/core"
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	"github.com/victoroliveirab/settlers/logger"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	wsUtils "github.com/victoroliveirab/settlers/router/ws/utils"
-	"github.com/victoroliveirab/settlers/utils"
 )
 
 func OnSetupRoundTimeoutCurry(room *entities.Room) func() {
@@ -22,14 +20,20 @@ func OnSetupRoundTimeoutCurry(room *entities.Room) func() {
 		logger.LogSystemMessage(fmt.Sprintf("onSetupRoundTimeout.%s", room.ID), fmt.Sprintf("handling timeout on %s for player %s", round.RoundTypeTranslation[currentRoundType], currentRoundPlayer))
 
 		if currentRoundType == round.SetupSettlement1 || currentRoundType == round.SetupSettlement2 {
-			availableSettlements, _ := game.AvailableVertices(currentRoundPlayer)
-			vertexID := utils.SliceGetRandom(availableSettlements, room.Rand)
-			game.BuildSettlement(currentRoundPlayer, vertexID)
+			command, err := timeoutCommand(room, currentRoundPlayer, core.CommandBuildSettlement)
+			if err == nil {
+				err = game.BuildSettlement(currentRoundPlayer, command.VertexID)
+			}
+			if err != nil {
+				logger.LogSystemError(fmt.Sprintf("onSetupRoundTimeout.%s -> game.BuildSettlement(%d)", room.ID, command.VertexID), 1, err)
+				return
+			}
 			room.StartSubRound(game.RoundType())
 			room.EnqueueBulkUpdate(
 				UpdateCurrentRoundPlayerState,
 				UpdateMapState,
 				UpdateEdgeState,
+				UpdateShipState,
 				UpdateVertexState,
 				UpdatePlayerHand,
 				UpdatePortsState,
@@ -40,9 +44,14 @@ func OnSetupRoundTimeoutCurry(room *entities.Room) func() {
 				UpdateLogs([]string{fmt.Sprintf("%s built a new settlement.", currentRoundPlayer)}),
 			)
 		} else {
-			availableRoads, _ := game.AvailableEdges(currentRoundPlayer)
-			edgeID := utils.SliceGetRandom(availableRoads, room.Rand)
-			game.BuildRoad(currentRoundPlayer, edgeID)
+			command, err := timeoutCommand(room, currentRoundPlayer, core.CommandBuildRoad)
+			if err == nil {
+				err = game.BuildRoad(currentRoundPlayer, command.EdgeID)
+			}
+			if err != nil {
+				logger.LogSystemError(fmt.Sprintf("onSetupRoundTimeout.%s -> game.BuildRoad(%d)", room.ID, command.EdgeID), 1, err)
+				return
+			}
 			handleEdgeClickSetupResponse(room, []string{fmt.Sprintf("%s built a new road.", currentRoundPlayer)})
 		}
 	}
@@ -79,6 +88,26 @@ func OnRegularRoundTimeoutCurry(room *entities.Room) func() {
 			}
 		}
 
+		if currentPlayerEntry != nil && currentPlayerEntry.Bot {
+			playBotRound(room)
+			return
+		}
+
+		game.EndRound(currentRoundPlayer)
+		handleEndRoundResponse(room, currentRoundPlayer)
+	}
+}
+
+func OnSpecialBuildTimeoutCurry(room *entities.Room) func() {
+	return func() {
+		game := room.Game
+		currentRoundPlayer := game.CurrentRoundPlayer().ID
+		logger.LogSystemMessage(fmt.Sprintf("onSpecialBuildTimeout.%s", room.ID), fmt.Sprintf("handling timeout for player %s", currentRoundPlayer))
+		if isBotSeat(room, currentRoundPlayer) {
+			if !playBotCommands(room, core.CommandEndRound) {
+				return
+			}
+		}
 		game.EndRound(currentRoundPlayer)
 		handleEndRoundResponse(room, currentRoundPlayer)
 	}
@@ -90,8 +119,15 @@ func OnMoveRobberTimeoutCurry(room *entities.Room) func() {
 		currentRoundPlayer := game.CurrentRoundPlayer().ID
 		logger.LogSystemMessage(fmt.Sprintf("onMoveRobberTimeout.%s", room.ID), fmt.Sprintf("handling timeout for player %s", currentRoundPlayer))
 
-		tileID := utils.SliceGetRandom(game.UnblockedTiles(), room.Rand)
-		game.MoveRobber(currentRoundPlayer, tileID)
+		// Keeps to the tiles the friendly robber and the fog of war allow
+		command, err := timeoutCommand(room, currentRoundPlayer, core.CommandMoveRobber)
+		if err == nil {
+			err = game.MoveRobber(currentRoundPlayer, command.TileID)
+		}
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("onMoveRobberTimeout.%s -> game.MoveRobber(%d)", room.ID, command.TileID), 1, err)
+			return
+		}
 		handleMoveRobberResponse(room)
 	}
 }
@@ -101,9 +137,15 @@ func OnPickRobbedTimeoutCurry(room *entities.Room) func() {
 		game := room.Game
 		currentRoundPlayer := game.CurrentRoundPlayer().ID
 		logger.LogSystemMessage(fmt.Sprintf("onPickRobbedTimeout.%s", room.ID), fmt.Sprintf("handling timeout for player %s", currentRoundPlayer))
-		playersToRob, _ := game.RobbablePlayers(currentRoundPlayer)
-		robbedPlayer := utils.SliceGetRandom(playersToRob, room.Rand)
-		game.RobPlayer(currentRoundPlayer, robbedPlayer)
+		command, err := timeoutCommand(room, currentRoundPlayer, core.CommandRobPlayer)
+		robbedPlayer := command.TargetPlayerID
+		if err == nil {
+			err = game.RobPlayer(currentRoundPlayer, robbedPlayer)
+		}
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("onPickRobbedTimeout.%s -> game.RobPlayer(%s)", room.ID, robbedPlayer), 1, err)
+			return
+		}
 		handlePickRobbedResponse(room, currentRoundPlayer, robbedPlayer)
 	}
 }
@@ -113,13 +155,15 @@ func OnBetweenTurnsTimeoutCurry(room *entities.Room) func() {
 		game := room.Game
 		currentRoundPlayer := game.CurrentRoundPlayer().ID
 		logger.LogSystemMessage(fmt.Sprintf("onBetweenTurnsTimeout.%s", room.ID), fmt.Sprintf("handling timeout for player %s", currentRoundPlayer))
-		game.RollDice(currentRoundPlayer)
-		prevResourceHands := map[string]map[string]int{}
-		for _, player := range game.Players() {
-			prevResourceHands[player.ID] = maps.Clone(game.ResourceHandByPlayer(player.ID))
+		if isBotSeat(room, currentRoundPlayer) {
+			// May play a knight before rolling
+			if !playBotCommands(room, core.CommandRollDice) {
+				return
+			}
 		}
-
-		handleDiceRollResponse(room, prevResourceHands)
+		stopWatching := watchProduction(game)
+		game.RollDice(currentRoundPlayer)
+		handleDiceRollResponse(room, stopWatching())
 	}
 }
 
@@ -136,13 +180,16 @@ func OnBuildRoadDevelopmentTimeoutCurry(room *entities.Room) func() {
 
 		logs := make([]string, 0)
 		for i := 0; i < numberOfRoadsToBuild; i++ {
-			availableRoads, err := game.AvailableEdges(currentRoundPlayer)
-			if err != nil {
+			command, ok := botFor(room, currentRoundPlayer).NextAction(game, currentRoundPlayer)
+			if !ok || command.Type != core.CommandPickRoadBuildingSpot {
 				// May have reached max number of roads
 				break
 			}
-			edgeID := utils.SliceGetRandom(availableRoads, room.Rand)
-			game.BuildRoad(currentRoundPlayer, edgeID)
+			err := game.PickRoadBuildingSpot(currentRoundPlayer, command.EdgeID)
+			if err != nil {
+				logger.LogSystemError(fmt.Sprintf("onBuildRoadDevelopmentTimeout.%s -> game.PickRoadBuildingSpot(%d)", room.ID, command.EdgeID), 1, err)
+				break
+			}
 			logs = append(logs, fmt.Sprintf("%s has built a new road.", currentRoundPlayer))
 		}
 
@@ -156,9 +203,15 @@ func OnMonopolyPickResourceTimeoutCurry(room *entities.Room) func() {
 		game := room.Game
 		currentRoundPlayer := game.CurrentRoundPlayer().ID
 		resourceCountBefore := game.NumberOfResourcesByPlayer()[currentRoundPlayer]
-		resource := utils.SliceGetRandom([]string{"Lumber", "Brick", "Sheep", "Grain", "Ore"}, room.Rand)
-
-		game.PickMonopolyResource(currentRoundPlayer, resource)
+		command, err := timeoutCommand(room, currentRoundPlayer, core.CommandPickMonopolyResource)
+		resource := command.Resource
+		if err == nil {
+			err = game.PickMonopolyResource(currentRoundPlayer, resource)
+		}
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("onMonopolyPickResourceTimeout.%s -> game.PickMonopolyResource(%s)", room.ID, resource), 1, err)
+			return
+		}
 		handleMonopolyResourceResponse(room, resource, resourceCountBefore)
 	}
 }
@@ -167,40 +220,47 @@ func OnYearOfPlentyPickResourcesTimeoutCurry(room *entities.Room) func() {
 	return func() {
 		game := room.Game
 		currentRoundPlayer := game.CurrentRoundPlayer().ID
-		resource1 := utils.SliceGetRandom([]string{"Lumber", "Brick", "Sheep", "Grain", "Ore"}, room.Rand)
-		resource2 := utils.SliceGetRandom([]string{"Lumber", "Brick", "Sheep", "Grain", "Ore"}, room.Rand)
-		game.PickYearOfPlentyResources(currentRoundPlayer, resource1, resource2)
+		command, err := timeoutCommand(room, currentRoundPlayer, core.CommandPickYearOfPlentyResources)
+		resource1 := command.Resource
+		resource2 := command.SecondResource
+		if err == nil {
+			err = game.PickYearOfPlentyResources(currentRoundPlayer, resource1, resource2)
+		}
+		if err != nil {
+			logger.LogSystemError(fmt.Sprintf("onYearOfPlentyPickResourcesTimeout.%s -> game.PickYearOfPlentyResources(%s, %s)", room.ID, resource1, resource2), 1, err)
+			return
+		}
 		handlePickYearOfPlentyResourcesResponse(room, resource1, resource2)
 	}
 }
 
-func distributeResources(input map[string]int, quantity int, randGenerator *rand.Rand) map[string]int {
-	result := make(map[string]int)
-	remaining := quantity
-
-	// Copy keys into a slice for randomized iteration
-	keys := make([]string, 0, len(input))
-	for k := range input {
-		keys = append(keys, k)
-	}
-
-	// Keep distributing 1 unit at a time randomly
-	for remaining > 0 {
-		// Shuffle keys each round to avoid bias
-		randGenerator.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+func OnGoldPickTimeoutCurry(room *entities.Room) func() {
+	return func() {
+		game := room.Game
+		goldPickAmounts := game.GoldPickAmounts()
 
-		for _, k := range keys {
-			if remaining == 0 {
-				break
+		logs := make([]string, 0)
+		// In seat order, so players draw from the bank the same way every time
+		for _, seat := range game.Players() {
+			player := seat.ID
+			if goldPickAmounts[player] == 0 {
+				continue
 			}
-			if result[k] < input[k] {
-				result[k]++
-				remaining--
+			command, ok := botFor(room, player).NextAction(game, player)
+			if !ok || command.Type != core.CommandPickGoldResources {
+				continue
+			}
+			pickedMap := command.Resources
+			err := game.PickGoldResources(player, pickedMap)
+			if err != nil {
+				logger.LogSystemError(fmt.Sprintf("onGoldPickTimeout.%s -> game.PickGoldResources(%s)", room.ID, player), 1, err)
+				continue
 			}
+			formattedResources := wsUtils.FormatResources(pickedMap)
+			logs = append(logs, fmt.Sprintf("%s picked %s from gold", player, formattedResources))
 		}
+		handlePickGoldResponse(room, logs)
 	}
-
-	return result
 }
 
 func OnDiscardPhaseTimeoutCurry(room *entities.Room) func() {
@@ -209,12 +269,22 @@ func OnDiscardPhaseTimeoutCurry(room *entities.Room) func() {
 		discardAmounts := game.DiscardAmounts()
 
 		logs := make([]string, 0)
-		for player, amount := range discardAmounts {
-			if amount == 0 {
+		// In seat order, so the bots decide the same way every time
+		for _, seat := range game.Players() {
+			player := seat.ID
+			if discardAmounts[player] == 0 {
+				continue
+			}
+			command, ok := botFor(room, player).NextAction(game, player)
+			if !ok || command.Type != core.CommandDiscardPlayerCards {
+				continue
+			}
+			discardMap := command.Resources
+			err := game.DiscardPlayerCards(player, discardMap)
+			if err != nil {
+				logger.LogSystemError(fmt.Sprintf("onDiscardPhaseTimeout.%s -> game.DiscardPlayerCards(%s)", room.ID, player), 1, err)
 				continue
 			}
-			discardMap := distributeResources(game.ResourceHandByPlayer(player), amount, room.Rand)
-			game.DiscardPlayerCards(player, discardMap)
 			formattedResources := wsUtils.FormatResources(discardMap)
 			logs = append(logs, fmt.Sprintf("%s discarded %s", player, formattedResources))
 		}
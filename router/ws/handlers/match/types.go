@@ -29,11 +29,20 @@ type edgesStateUpdateResponsePayload struct {
 	Highlight      bool  `json:"highlight"`
 }
 
+type shipsStateUpdateResponsePayload struct {
+	AvailableEdges []int `json:"availableEdges"`
+	Enabled        bool  `json:"enabled"`
+	MovableShips   []int `json:"movableShips"`
+}
+
 type mapStateUpdateResponsePayload struct {
 	BlockedTiles []int                  `json:"blockedTiles"`
 	Cities       map[int]board.Building `json:"cities"`
 	Roads        map[int]board.Building `json:"roads"`
 	Settlements  map[int]board.Building `json:"settlements"`
+	Ships        map[int]board.Building `json:"ships"`
+	// Explored tiles show up as the game goes on, so the tiles are resent along with the buildings
+	Tiles []coreT.MapBlock `json:"tiles"`
 }
 
 type portStateUpdateResponsePayload struct {
@@ -46,7 +55,7 @@ type diceStateUpdateResponsePayload struct {
 }
 
 type handStateUpdateResponsePayload struct {
-	Hand map[string]int `json:"hand"`
+	Hand coreT.Resources `json:"hand"`
 }
 
 type devHandStateUpdateResponsePayload struct {
@@ -57,10 +66,19 @@ type devHandPermissionsStateUpdateResponsePayload struct {
 	DevHandPermissions map[string]bool `json:"devHandPermissions"`
 }
 
+type tradeTokensStateUpdateResponsePayload struct {
+	Cost        int            `json:"cost"`
+	TradeTokens map[string]int `json:"tradeTokens"`
+}
+
 type resourceCountStateUpdateResponsePayload struct {
 	ResourceCount map[string]int `json:"resourceCount"`
 }
 
+type bankStateUpdateResponsePayload struct {
+	Bank coreT.Resources `json:"bank"`
+}
+
 type devHandCountStateUpdateResponsePayload struct {
 	DevHandCount map[string]int `json:"devHandCount"`
 }
@@ -76,6 +94,11 @@ type discardPhaseStateUpdateResponsePayload struct {
 	Enabled        bool           `json:"enabled"`
 }
 
+type goldPickStateUpdateResponsePayload struct {
+	Enabled         bool           `json:"enabled"`
+	GoldPickAmounts map[string]int `json:"goldPickAmounts"`
+}
+
 type passStateUpdateResponsePayload struct {
 	Enabled bool `json:"enabled"`
 }
@@ -118,26 +141,31 @@ type yearOfPlentyStateUpdate struct {
 }
 
 type hydrateSetupMatchResponsePayload struct {
+	Bank              coreT.Resources                `json:"bank"`
 	DevHandCount      map[string]int                 `json:"devHandCount"`
 	EdgeUpdate        *types.WebSocketServerResponse `json:"edgeUpdate"`
 	Map               []coreT.MapBlock               `json:"map"`
 	MapName           string                         `json:"mapName"`
 	MapUpdate         *types.WebSocketServerResponse `json:"mapUpdate"`
+	NeutralPlayers    []coreT.Player                 `json:"neutralPlayers"`
 	Players           []coreT.Player                 `json:"players"`
 	Ports             []coreT.Port                   `json:"ports"`
 	ResourceCount     map[string]int                 `json:"resourceCount"`
 	RoomStatus        string                         `json:"roomStatus"`
 	RoundPlayerUpdate *types.WebSocketServerResponse `json:"roundPlayerUpdate"`
+	SeaTiles          []coreT.MapBlock               `json:"seaTiles"`
 	VertexUpdate      *types.WebSocketServerResponse `json:"vertexUpdate"`
 }
 
 type hydrateOngoingMatchResponsePayload struct {
+	Bank                     coreT.Resources                `json:"bank"`
 	BuyDevCardUpdate         *types.WebSocketServerResponse `json:"buyDevCardUpdate"`
 	DevHandCount             map[string]int                 `json:"devHandCount"`
 	DevHandUpdate            *types.WebSocketServerResponse `json:"devHandUpdate"`
 	DevHandPermissionsUpdate *types.WebSocketServerResponse `json:"devHandPermissionsUpdate"`
 	DiceUpdate               *types.WebSocketServerResponse `json:"diceUpdate"`
 	DiscardUpdate            *types.WebSocketServerResponse `json:"discardUpdate"`
+	GoldPickUpdate           *types.WebSocketServerResponse `json:"goldPickUpdate"`
 	EdgeUpdate               *types.WebSocketServerResponse `json:"edgeUpdate"`
 	HandUpdate               *types.WebSocketServerResponse `json:"handUpdate"`
 	KnightsUsageUpdate       *types.WebSocketServerResponse `json:"knightsUsageUpdate"`
@@ -147,6 +175,7 @@ type hydrateOngoingMatchResponsePayload struct {
 	MapUpdate                *types.WebSocketServerResponse `json:"mapUpdate"`
 	MonopolyUpdate           *types.WebSocketServerResponse `json:"monopolyUpdate"`
 	PassActionState          *types.WebSocketServerResponse `json:"passActionState"`
+	NeutralPlayers           []coreT.Player                 `json:"neutralPlayers"`
 	Players                  []coreT.Player                 `json:"players"`
 	PointsUpdate             *types.WebSocketServerResponse `json:"pointsUpdate"`
 	Ports                    []coreT.Port                   `json:"ports"`
@@ -156,8 +185,11 @@ type hydrateOngoingMatchResponsePayload struct {
 	RobberUpdate             *types.WebSocketServerResponse `json:"robberMovementUpdate"`
 	RoomStatus               string                         `json:"roomStatus"`
 	RoundPlayerUpdate        *types.WebSocketServerResponse `json:"roundPlayerUpdate"`
+	SeaTiles                 []coreT.MapBlock               `json:"seaTiles"`
+	ShipUpdate               *types.WebSocketServerResponse `json:"shipUpdate"`
 	TradeActionState         *types.WebSocketServerResponse `json:"tradeActionState"`
 	TradeOffersUpdate        *types.WebSocketServerResponse `json:"tradeOffersUpdate"`
+	TradeTokensUpdate        *types.WebSocketServerResponse `json:"tradeTokensUpdate"`
 	VertexUpdate             *types.WebSocketServerResponse `json:"vertexUpdate"`
 	YearOfPlentyUpdate       *types.WebSocketServerResponse `json:"yearOfPlentyUpdate"`
 }
@@ -57,6 +57,20 @@ func UpdateEdgeState(room *entities.Room, username string) *types.WebSocketServe
 	}
 }
 
+func UpdateShipState(room *entities.Room, username string) *types.WebSocketServerResponse {
+	game := room.Game
+	messageType := fmt.Sprintf("%s.update-ships", room.Status)
+	availableEdges, err := game.AvailableShipEdges(username)
+	return &types.WebSocketServerResponse{
+		Type: types.ResponseType(messageType),
+		Payload: shipsStateUpdateResponsePayload{
+			AvailableEdges: availableEdges,
+			Enabled:        err == nil,
+			MovableShips:   game.MovableShips(username),
+		},
+	}
+}
+
 func UpdateDiceState(room *entities.Room, username string) *types.WebSocketServerResponse {
 	game := room.Game
 	messageType := fmt.Sprintf("%s.update-dice", room.Status)
@@ -82,6 +96,8 @@ func UpdateMapState(room *entities.Room, username string) *types.WebSocketServer
 			Cities:       game.GetAllCities(),
 			Roads:        game.GetAllRoads(),
 			Settlements:  game.GetAllSettlements(),
+			Ships:        game.GetAllShips(),
+			Tiles:        game.GetBoard(),
 		},
 	}
 }
@@ -150,6 +166,29 @@ func UpdateResourceCount(room *entities.Room, username string) *types.WebSocketS
 	}
 }
 
+func UpdateTradeTokens(room *entities.Room, username string) *types.WebSocketServerResponse {
+	game := room.Game
+	messageType := fmt.Sprintf("%s.update-trade-tokens", room.Status)
+	return &types.WebSocketServerResponse{
+		Type: types.ResponseType(messageType),
+		Payload: tradeTokensStateUpdateResponsePayload{
+			Cost:        game.TradeTokenCost(username),
+			TradeTokens: game.TradeTokens(),
+		},
+	}
+}
+
+func UpdateBank(room *entities.Room, username string) *types.WebSocketServerResponse {
+	game := room.Game
+	messageType := fmt.Sprintf("%s.update-bank", room.Status)
+	return &types.WebSocketServerResponse{
+		Type: types.ResponseType(messageType),
+		Payload: bankStateUpdateResponsePayload{
+			Bank: game.BankResources(),
+		},
+	}
+}
+
 func UpdateDevHandCount(room *entities.Room, username string) *types.WebSocketServerResponse {
 	game := room.Game
 	messageType := fmt.Sprintf("%s.update-dev-hand-count", room.Status)
@@ -170,7 +209,7 @@ func UpdateRobberMovement(room *entities.Room, username string) *types.WebSocket
 	return &types.WebSocketServerResponse{
 		Type: types.ResponseType(messageType),
 		Payload: moveRobberStateUpdateResponsePayload{
-			AvailableTiles: game.UnblockedTiles(),
+			AvailableTiles: game.RobberDestinations(),
 			Enabled:        enabled,
 			Highlight:      enabled,
 		},
@@ -189,6 +228,18 @@ func UpdateDiscardPhase(room *entities.Room, username string) *types.WebSocketSe
 	}
 }
 
+func UpdateGoldPick(room *entities.Room, username string) *types.WebSocketServerResponse {
+	game := room.Game
+	messageType := fmt.Sprintf("%s.update-gold-pick", room.Status)
+	return &types.WebSocketServerResponse{
+		Type: types.ResponseType(messageType),
+		Payload: goldPickStateUpdateResponsePayload{
+			Enabled:         game.GoldPickAmountByPlayer(username) > 0,
+			GoldPickAmounts: game.GoldPickAmounts(),
+		},
+	}
+}
+
 func UpdatePass(room *entities.Room, username string) *types.WebSocketServerResponse {
 	game := room.Game
 	messageType := fmt.Sprintf("%s.update-pass", room.Status)
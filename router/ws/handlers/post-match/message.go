@@ -17,6 +17,7 @@ func BuildPostMatchMessage(room *entities.Room) *types.WebSocketServerResponse {
 			RoundsPlayed:  game.Round() + 1,
 			StartDatetime: room.StartDatetime,
 			EndDatetime:   room.EndDatetime,
+			BoardCode:     game.BoardCode(),
 		},
 	}
 }
@@ -36,6 +37,7 @@ func BuildPostMatchHydrateMessage(room *entities.Room) *types.WebSocketServerRes
 			EndDatetime:   room.EndDatetime,
 			Players:       game.Players(),
 			MapName:       game.MapName(),
+			BoardCode:     game.BoardCode(),
 		},
 	}
 }
@@ -13,6 +13,7 @@ type postMatchDataResponsePayload struct {
 	RoundsPlayed  int                  `json:"roundsPlayed"`
 	StartDatetime time.Time            `json:"startDatetime"`
 	EndDatetime   time.Time            `json:"endDatetime"`
+	BoardCode     string               `json:"boardCode"`
 }
 
 type postMatchHydrateResponsePayload struct {
@@ -24,4 +25,5 @@ type postMatchHydrateResponsePayload struct {
 	EndDatetime   time.Time            `json:"endDatetime"`
 	Players       []coreT.Player       `json:"players"`
 	MapName       string               `json:"mapName"`
+	BoardCode     string               `json:"boardCode"`
 }
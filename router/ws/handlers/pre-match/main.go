@@ -3,6 +3,7 @@ package prematch
 import (
 	"fmt"
 
+	"github.com/victoroliveirab/settlers/core"
 	"github.com/victoroliveirab/settlers/core/packages/round"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 	"github.com/victoroliveirab/settlers/router/ws/handlers/match"
@@ -78,6 +79,47 @@ func TryHandle(player *entities.GamePlayer, message *types.WebSocketClientReques
 			return true, wsErr
 		}
 
+		room.EnqueueOutgoingMessage(BuildRoomMessage(room, fmt.Sprintf("%s.success", message.Type)), nil, nil)
+		return true, nil
+	case "room.add-bot":
+		requestPayload, err := utils.ParseJsonPayload[roomAddBotRequestPayload](message)
+		if err != nil {
+			wsErr := player.WriteJsonError(message.Type, err)
+			return true, wsErr
+		}
+
+		room := player.Room
+		err = room.AddBot(player, requestPayload.Difficulty)
+		if err != nil {
+			wsErr := player.WriteJsonError(message.Type, err)
+			return true, wsErr
+		}
+
+		room.EnqueueOutgoingMessage(BuildRoomMessage(room, fmt.Sprintf("%s.success", message.Type)), nil, nil)
+		return true, nil
+	case "room.set-scenario":
+		requestPayload, err := utils.ParseJsonPayload[roomSetScenarioRequestPayload](message)
+		if err != nil {
+			wsErr := player.WriteJsonError(message.Type, err)
+			return true, wsErr
+		}
+
+		var scenario *core.Scenario
+		if len(requestPayload.Scenario) > 0 && string(requestPayload.Scenario) != "null" {
+			scenario, err = core.ParseScenario(requestPayload.Scenario)
+			if err != nil {
+				wsErr := player.WriteJsonError(message.Type, err)
+				return true, wsErr
+			}
+		}
+
+		room := player.Room
+		err = room.SetScenario(player, scenario)
+		if err != nil {
+			wsErr := player.WriteJsonError(message.Type, err)
+			return true, wsErr
+		}
+
 		room.EnqueueOutgoingMessage(BuildRoomMessage(room, fmt.Sprintf("%s.success", message.Type)), nil, nil)
 		return true, nil
 	case "room.start-game":
@@ -88,6 +130,13 @@ func TryHandle(player *entities.GamePlayer, message *types.WebSocketClientReques
 			return true, wsErr
 		}
 
+		if room.Scenario != nil {
+			room.EnqueueOutgoingMessage(buildStartMatch(room), nil, func() {
+				startScenarioMatch(room)
+			})
+			return true, nil
+		}
+
 		room.EnqueueOutgoingMessage(buildStartMatch(room), nil, func() {
 			room.StartRound()
 			room.StartSubRound(round.SetupSettlement1)
@@ -21,13 +21,20 @@ func BuildRoomMessage(room *entities.Room, messageType string) *types.WebSocketS
 func buildStartMatch(room *entities.Room) *types.WebSocketServerResponse {
 	game := room.Game
 	responsePayload := roomStartMatchPayload{
-		Map:           game.GetBoard(),
-		MapName:       game.MapName(),
-		Players:       game.Players(),
-		Ports:         game.Ports(),
-		ResourceCount: game.NumberOfResourcesByPlayer(),
-		RoomStatus:    room.Status,
-		Logs:          []string{},
+		Bank:           game.BankResources(),
+		Map:            game.GetBoard(),
+		MapName:        game.MapName(),
+		NeutralPlayers: game.NeutralPlayers(),
+		Players:        game.Players(),
+		Ports:          game.Ports(),
+		ResourceCount:  game.NumberOfResourcesByPlayer(),
+		RoomStatus:     room.Status,
+		SeaTiles:       game.GetSeaTiles(),
+		Logs:           []string{},
+	}
+	if !game.GetSettings().FogOfWar {
+		balance := game.BoardBalance()
+		responsePayload.Balance = &balance
 	}
 	msg := &types.WebSocketServerResponse{
 		Type:    types.ResponseType("room.start-game.success"),
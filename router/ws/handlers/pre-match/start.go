@@ -12,30 +12,11 @@ import (
 )
 
 func metaEntriesToParams(entries []entities.RoomParamsMetaEntry) *core.Params {
-	params := core.Params{}
-	valueMap := map[string]*int{
-		"speed":                &params.Speed,
-		"bankTradeAmount":      &params.BankTradeAmount,
-		"maxCards":             &params.MaxCards,
-		"maxDevCardsPerRound":  &params.MaxDevCardsPerRound,
-		"maxSettlements":       &params.MaxSettlements,
-		"maxCities":            &params.MaxCities,
-		"maxRoads":             &params.MaxRoads,
-		"targetPoint":          &params.TargetPoint,
-		"pointsPerSettlement":  &params.PointsPerSettlement,
-		"pointsPerCity":        &params.PointsPerCity,
-		"pointsForMostKnights": &params.PointsForMostKnights,
-		"pointsForLongestRoad": &params.PointsForLongestRoad,
-		"mostKnightsMinimum":   &params.MostKnightsMinimum,
-		"longestRoadMinimum":   &params.LongestRoadMinimum,
-	}
-
+	values := make(map[string]int)
 	for _, entry := range entries {
-		if ptr, ok := valueMap[entry.Key]; ok {
-			*ptr = entry.Value
-		}
+		values[entry.Key] = entry.Value
 	}
-
+	params := core.ParamsFromMap(values)
 	return &params
 }
 
@@ -55,15 +36,27 @@ func StartMatch(player *entities.GamePlayer, room *entities.Room) error {
 	}
 
 	params := metaEntriesToParams(room.Params())
-	err := gameState.New(players, room.MapName, room.Rand, *params)
-	if err != nil {
-		return err
+	params.BoardCode = room.BoardCode
+	if room.Scenario != nil {
+		err := gameState.NewFromScenario(players, room.Scenario, room.Rand.Int63(), *params)
+		if err != nil {
+			return err
+		}
+		logger.LogSystemMessage("StartMatch", fmt.Sprintf("%s scenario=%q %s seed=%d %v", room.ID, room.Scenario.Name, room.Scenario.Map, gameState.Seed(), params))
+	} else {
+		err := gameState.NewWithSeed(players, room.MapName, room.Rand.Int63(), *params)
+		if err != nil {
+			return err
+		}
+		logger.LogSystemMessage("StartMatch", fmt.Sprintf("%s %s seed=%d %v", room.ID, room.MapName, gameState.Seed(), params))
 	}
 
-	logger.LogSystemMessage("StartMatch", fmt.Sprintf("%s %s %v", room.ID, room.MapName, params))
-
 	room.Game = gameState
 	room.ProgressStatus()
+	if room.Scenario != nil {
+		// Scenarios skip the setup phase
+		room.ProgressStatus()
+	}
 
 	onSetupRoundTimeout := match.OnSetupRoundTimeoutCurry(room)
 	onRegularRoundTimeout := match.OnRegularRoundTimeoutCurry(room)
@@ -75,6 +68,8 @@ func StartMatch(player *entities.GamePlayer, room *entities.Room) error {
 	onMonopolyPickResourceTimeout := match.OnMonopolyPickResourceTimeoutCurry(room)
 	onYearOfPlentyPickResourcesTimeout := match.OnYearOfPlentyPickResourcesTimeoutCurry(room)
 	onDiscardPhaseTimeout := match.OnDiscardPhaseTimeoutCurry(room)
+	onGoldPickTimeout := match.OnGoldPickTimeoutCurry(room)
+	onSpecialBuildTimeout := match.OnSpecialBuildTimeoutCurry(room)
 
 	room.CreateRoundManager(onRegularRoundTimeout, map[round.Type]func(){
 		round.SetupSettlement1:          onSetupRoundTimeout,
@@ -91,6 +86,39 @@ func StartMatch(player *entities.GamePlayer, room *entities.Room) error {
 		round.MonopolyPickResource:      onMonopolyPickResourceTimeout,
 		round.YearOfPlentyPickResources: onYearOfPlentyPickResourcesTimeout,
 		round.DiscardPhase:              onDiscardPhaseTimeout,
+		round.SpecialBuild:              onSpecialBuildTimeout,
+		round.GoldPick:                  onGoldPickTimeout,
 	})
 	return nil
 }
+
+// startScenarioMatch starts the round a scenario left off at and sends everyone the full match state
+func startScenarioMatch(room *entities.Room) {
+	game := room.Game
+	room.StartRound()
+	if game.RoundType() != round.Regular {
+		room.StartSubRound(game.RoundType())
+	}
+	logs := []string{"Match starting. Good luck to everyone!"}
+	if room.Scenario.Name != "" {
+		logs = []string{fmt.Sprintf("Scenario %s loaded.", room.Scenario.Name), logs[0]}
+	}
+	room.EnqueueBulkUpdate(
+		match.UpdateCurrentRoundPlayerState,
+		match.UpdateMapState,
+		match.UpdateVertexState,
+		match.UpdateEdgeState,
+		match.UpdatePortsState,
+		match.UpdatePlayerHand,
+		match.UpdatePlayerDevHand,
+		match.UpdatePlayerDevHandPermissions,
+		match.UpdateResourceCount,
+		match.UpdateBank,
+		match.UpdateDiceState,
+		match.UpdateBuyDevelopmentCard,
+		match.UpdateKnightUsage,
+		match.UpdateLongestRoadSize,
+		match.UpdatePoints,
+		match.UpdateLogs(logs),
+	)
+}
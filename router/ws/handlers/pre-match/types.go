@@ -1,6 +1,10 @@
 package prematch
 
 import (
+	"encoding/json"
+
+	"github.com/victoroliveirab/settlers/bot"
+	"github.com/victoroliveirab/settlers/core/maps"
 	coreT "github.com/victoroliveirab/settlers/core/types"
 	"github.com/victoroliveirab/settlers/router/ws/entities"
 )
@@ -26,6 +30,15 @@ type roomPlayerReadyRequestPayload struct {
 	Ready bool `json:"ready"`
 }
 
+type roomAddBotRequestPayload struct {
+	Difficulty bot.Difficulty `json:"difficulty"`
+}
+
+type roomSetScenarioRequestPayload struct {
+	// Null or absent goes back to a regular match
+	Scenario json.RawMessage `json:"scenario"`
+}
+
 type roomUpdateResponsePayload struct {
 	MinMaxPlayers [2]int                         `json:"minMaxPlayers"`
 	Room          *entities.Room                 `json:"room"`
@@ -33,11 +46,16 @@ type roomUpdateResponsePayload struct {
 }
 
 type roomStartMatchPayload struct {
-	Map           []coreT.MapBlock `json:"map"`
-	MapName       string           `json:"mapName"`
-	Players       []coreT.Player   `json:"players"`
-	Ports         []coreT.Port     `json:"ports"`
-	ResourceCount map[string]int   `json:"resourceCount"`
-	RoomStatus    string           `json:"roomStatus"`
-	Logs          []string         `json:"logs"`
+	// Left out with fog of war, since it would tell about the hidden tiles
+	Balance        *maps.BalanceReport `json:"balance,omitempty"`
+	Bank           coreT.Resources     `json:"bank"`
+	Map            []coreT.MapBlock    `json:"map"`
+	MapName        string              `json:"mapName"`
+	NeutralPlayers []coreT.Player      `json:"neutralPlayers"`
+	Players        []coreT.Player      `json:"players"`
+	Ports          []coreT.Port        `json:"ports"`
+	ResourceCount  map[string]int      `json:"resourceCount"`
+	RoomStatus     string              `json:"roomStatus"`
+	SeaTiles       []coreT.MapBlock    `json:"seaTiles"`
+	Logs           []string            `json:"logs"`
 }
@@ -5,13 +5,14 @@ import (
 	"strings"
 
 	"github.com/victoroliveirab/settlers/core"
+	coreT "github.com/victoroliveirab/settlers/core/types"
 )
 
-func FormatResources(collection map[string]int) string {
+func FormatResources(collection coreT.Resources) string {
 	var sb strings.Builder
 	for _, resource := range core.ResourcesOrder {
-		quantity, ok := collection[resource]
-		if !ok || quantity == 0 {
+		quantity := collection.Get(resource)
+		if quantity == 0 {
 			continue
 		}
 		sb.WriteString(fmt.Sprintf("[res q=%d v=%s] ", quantity, resource))
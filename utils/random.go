@@ -2,14 +2,76 @@ package utils
 
 import (
 	"math/rand"
+	"reflect"
 	"time"
 )
 
 func RandNew(seed int64) *rand.Rand {
+	return rand.New(NewRandSource(seed))
+}
+
+// RandSource wraps the default math/rand source and keeps track of its seed and of how many
+// values it has produced, so the exact same position can be recreated later on.
+type RandSource struct {
+	seed   int64
+	draws  uint64
+	source rand.Source64
+}
+
+func NewRandSource(seed int64) *RandSource {
 	if seed == 0 {
 		seed = time.Now().UnixNano()
 	}
-	source := rand.NewSource(seed)
-	generator := rand.New(source)
-	return generator
+	return &RandSource{
+		seed:   seed,
+		draws:  0,
+		source: rand.NewSource(seed).(rand.Source64),
+	}
+}
+
+// RestoreRandSource recreates a source with the given seed that has already produced draws values
+func RestoreRandSource(seed int64, draws uint64) *RandSource {
+	s := NewRandSource(seed)
+	for s.draws < draws {
+		s.Int63()
+	}
+	return s
+}
+
+func (s *RandSource) Int63() int64 {
+	s.draws++
+	return s.source.Int63()
+}
+
+func (s *RandSource) Uint64() uint64 {
+	s.draws++
+	return s.source.Uint64()
+}
+
+func (s *RandSource) Seed(seed int64) {
+	s.seed = seed
+	s.draws = 0
+	s.source.Seed(seed)
+}
+
+func (s *RandSource) Position() (int64, uint64) {
+	return s.seed, s.draws
+}
+
+// Clone returns an independent source that will produce the same values from this point on.
+// The generator state is copied as is, so the values drawn so far aren't drawn again
+func (s *RandSource) Clone() *RandSource {
+	return &RandSource{
+		seed:   s.seed,
+		draws:  s.draws,
+		source: copySource(s.source),
+	}
+}
+
+// copySource copies the generator math/rand hands out, whose type isn't exported
+func copySource(source rand.Source64) rand.Source64 {
+	state := reflect.ValueOf(source).Elem()
+	copied := reflect.New(state.Type())
+	copied.Elem().Set(state)
+	return copied.Interface().(rand.Source64)
 }